@@ -18,6 +18,7 @@ import (
 var (
 	fix        bool
 	configFile string
+	cssEntry   string
 	Version    = "dev"
 )
 
@@ -27,12 +28,16 @@ var rootCmd = &cobra.Command{
 	Long:  "A fast, standalone binary to sort Tailwind CSS classes in your project files.",
 	Args:  cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		config, err := config.New(configFile)
+		config, err := config.New(configFile, cssEntry)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, color.RedString("Error loading configuration: %v", err))
 			os.Exit(1)
 		}
 
+		for _, warning := range config.Warnings {
+			fmt.Fprintln(os.Stderr, color.YellowString("Warning: %s", warning))
+		}
+
 		sorterService, err := service.SorterServiceNew(config, fix)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, color.RedString("Error initializing sorter: %v", err))
@@ -122,13 +127,14 @@ func processViolation(filePath string, content []byte, violation service.Violati
 		fmt.Fprintf(os.Stderr, "  %s %s %s\n", lineNumberColor.Sprint(paddedLineNum), pipeColor.Sprint("|"), lines[idx])
 
 		if idx == violation.Line-1 {
-			pointerWidth := max(violation.EndOffset-violation.StartOffset, 1)
+			// Only the first line of a multi-line attribute is underlined.
+			pointerWidth := max(min(violation.EndOffset-violation.StartOffset, len(lines[idx])-violation.Col+1), 1)
 
 			fmt.Fprintf(os.Stderr, "  %s %s %s%s %s\n", strings.Repeat(" ", maxLineNumWidth), pipeColor.Sprint("|"), strings.Repeat(" ", violation.Col-1), pointerColor.Sprint(strings.Repeat("^", pointerWidth)), pointerColor.Sprint(violation.Rule))
 		}
 	}
 
-	fmt.Fprintf(os.Stderr, "  %s %s %s\n\n", helpColor.Sprint("="), color.New(color.FgCyan).Sprint("help:"), helpColor.Sprint("Sort the Tailwind CSS classes in the attribute"))
+	fmt.Fprintf(os.Stderr, "  %s %s %s\n\n", helpColor.Sprint("="), color.New(color.FgCyan).Sprint("help:"), helpColor.Sprint(violation.Help))
 }
 
 func Execute() {
@@ -141,6 +147,7 @@ func Execute() {
 func init() {
 	rootCmd.Version = Version
 	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "Path to a custom TOML config file.")
+	rootCmd.PersistentFlags().StringVar(&cssEntry, "css", "", "Path to the Tailwind CSS entry file.")
 
 	rootCmd.Flags().BoolVar(&fix, "fix", false, "Apply fixes to the files.")
 }
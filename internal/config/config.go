@@ -3,12 +3,29 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 
 	"github.com/BurntSushi/toml"
 )
 
 const DefaultConfigFileName string = "tailwind-sorter.toml"
 
+// Positions of the unknown classes and of the custom groups in the sorted
+// class list. Unknown classes can also keep the position the author gave them.
+const (
+	PositionStart    string = "start"
+	PositionEnd      string = "end"
+	PositionPreserve string = "preserve"
+)
+
+// Tailwind CSS v3 applies the variants of a class from right to left, v4 from
+// left to right.
+const (
+	VariantSemanticsV3 string = "v3"
+	VariantSemanticsV4 string = "v4"
+)
+
 type TomlRoot struct {
 	Tool ToolSelection `toml:"tool"`
 }
@@ -18,18 +35,115 @@ type ToolSelection struct {
 }
 
 type UserConfig struct {
-	FilePatterns    []string `toml:"file_patterns"`
-	ClassAttributes []string `toml:"class_attributes"`
+	FilePatterns       []string                     `toml:"file_patterns"`
+	ClassAttributes    []string                     `toml:"class_attributes"`
+	HTMLFilePatterns   []string                     `toml:"html_file_patterns"`
+	JSXFilePatterns    []string                     `toml:"jsx_file_patterns"`
+	VueFilePatterns    []string                     `toml:"vue_file_patterns"`
+	ScanComments       bool                         `toml:"scan_comments"`
+	ScanRawText        []string                     `toml:"scan_raw_text"`
+	PreserveWhitespace bool                         `toml:"preserve_whitespace"`
+	ClassFunctions     []string                     `toml:"class_functions"`
+	OrderFromCSS       string                       `toml:"order_from_css"`
+	CSSEntry           string                       `toml:"css_entry"`
+	TailwindConfig     string                       `toml:"tailwind_config"`
+	Presets            []string                     `toml:"presets"`
+	TailwindPrefix     string                       `toml:"tailwind_prefix"`
+	DaisyUIPrefix      string                       `toml:"daisyui_prefix"`
+	VariantSemantics   string                       `toml:"variant_semantics"`
+	ClassOrder         []string                     `toml:"class_order"`
+	ClassOrderPrepend  []string                     `toml:"class_order_prepend"`
+	ClassOrderAppend   []string                     `toml:"class_order_append"`
+	ClassOrderInsert   []ClassOrderInsert           `toml:"class_order_insert"`
+	VariantOrder       map[string]VariantOrderEntry `toml:"variant_order"`
+	UnknownClasses     string                       `toml:"unknown_classes"`
+	CustomGroups       []CustomGroup                `toml:"custom_groups"`
+}
+
+// ClassOrderInsert adds entries right before or right after an existing
+// class order entry.
+type ClassOrderInsert struct {
+	Before  string   `toml:"before"`
+	After   string   `toml:"after"`
+	Entries []string `toml:"entries"`
+}
+
+// CustomGroup ranks the classes matching a regular expression either at the
+// start or the end of the class list, or right before or after an existing
+// class order entry.
+type CustomGroup struct {
+	Name     string `toml:"name"`
+	Pattern  string `toml:"pattern"`
+	Position string `toml:"position"`
+	Before   string `toml:"before"`
+	After    string `toml:"after"`
+}
+
+// VariantOrderEntry ranks a variant right before or right after an existing
+// one. A breakpoint is ranked by its width instead. Variants with none of them
+// are ranked after every known variant.
+type VariantOrderEntry struct {
+	Before     string `toml:"before"`
+	After      string `toml:"after"`
+	Breakpoint string `toml:"breakpoint"`
 }
 
 type Config struct {
-	ClassOrder      []string
-	VariantOrder    map[string]int
-	FilePatterns    []string
-	ClassAttributes []string
+	ClassOrder   []string
+	VariantOrder map[string]int
+	// CustomVariants are the variants defined by the project, whose selectors
+	// are unknown.
+	CustomVariants      map[string]struct{}
+	PropertyOrder       []string
+	StaticUtilities     map[string][]string
+	FunctionalUtilities map[string][]string
+	ValueUtilities      map[string]map[string][]string
+	ModifierUtilities   map[string][]string
+	FilePatterns        []string
+	ClassAttributes     []string
+	HTMLFilePatterns    []string
+	JSXFilePatterns     []string
+	VueFilePatterns     []string
+	ScanComments        bool
+	ScanRawText         []string
+	PreserveWhitespace  bool
+	ClassFunctions      []string
+	OrderFromCSS        string
+	CSSOrder            map[string]int
+	CSSEntry            string
+	TailwindConfig      string
+	Prefix              string
+	Separator           string
+	// Plugins are the names of the plugins the project loads, such as
+	// `daisyui` or `@tailwindcss/typography`.
+	Plugins          []string
+	UnknownClasses   string
+	VariantSemantics string
+	CustomGroups     []CustomGroup
+	Theme            Theme
+	DaisyUI          DaisyUIConfig
+	Warnings         []string
+
+	// daisyUIEntries are the class order entries of the daisyUI preset, which
+	// take the daisyUI prefix.
+	daisyUIEntries map[string]struct{}
+}
+
+type Theme struct {
+	Breakpoints map[string]string
+	Containers  map[string]string
+	Colors      map[string]string
 }
 
-func New(configFile string) (*Config, error) {
+type DaisyUIConfig struct {
+	// Enabled tells whether the project loads the daisyUI plugin.
+	Enabled bool
+	Prefix  string
+	Include []string
+	Exclude []string
+}
+
+func New(configFile string, cssEntry string) (*Config, error) {
 	config := defaultConfig()
 
 	if configFile == "" {
@@ -38,344 +152,237 @@ func New(configFile string) (*Config, error) {
 		}
 	}
 
+	var userConfig *UserConfig
 	if configFile != "" {
 		var tomlRoot TomlRoot
 		if _, err := toml.DecodeFile(configFile, &tomlRoot); err != nil {
 			return nil, fmt.Errorf("failed to parse config file %s: %w", configFile, err)
 		}
 
-		config.merge(&tomlRoot.Tool.Sorter)
-	}
-
-	return config, nil
-}
-
-func defaultConfig() *Config {
-	return &Config{
-		ClassOrder: []string{
-			// daisyUI Skeleton
-			"skeleton",
-
-			// daisyUI Button
-			"btn", "btn-primary", "btn-secondary", "btn-accent", "btn-neutral", "btn-info", "btn-success", "btn-warning",
-			"btn-error", "btn-outline", "btn-dash", "btn-soft", "btn-ghost", "btn-link", "btn-active", "btn-disabled", "btn-xs",
-			"btn-sm", "btn-md", "btn-lg", "btn-xl", "btn-wide", "btn-block", "btn-square", "btn-circle",
-
-			// daisyUI Dropdown
-			"dropdown", "dropdown-content", "dropdown-start", "dropdown-center", "dropdown-end", "dropdown-top", "dropdown-bottom",
-			"dropdown-left", "dropdown-right", "dropdown-hover", "dropdown-open",
-
-			// daisyUI Fab / Speed Dial
-			"fab", "fab-close", "fap-main-action", "fab-flower",
+		userConfig = &tomlRoot.Tool.Sorter
+		userConfig.OrderFromCSS = resolveConfigPath(configFile, userConfig.OrderFromCSS)
+		userConfig.CSSEntry = resolveConfigPath(configFile, userConfig.CSSEntry)
+		userConfig.TailwindConfig = resolveConfigPath(configFile, userConfig.TailwindConfig)
 
-			// daisyUI Modal
-			"modal", "modal-box", "modal-action", "modal-backdrop", "modal-toggle", "modal-open", "modal-top", "modal-middle",
-			"modal-bottom", "mdoal-start", "modal-end",
-
-			// daisyUI Swap
-			"swap", "swap-on", "swap-off", "swap-indeterminate", "swap-active", "swap-rotate", "swap-flip",
-
-			// daisyUI Accordion / Collapse
-			"collapse", "collapse-title", "collapse-content", "collapse-arrow", "collapse-plus", "collapse-open", "collapse-close",
-
-			// daisyUI Avatar
-			"avatar", "avatar-group", "avatar-online", "avatar-offline", "avatar-placeholder",
-
-			// daisyUI Badge
-			"badge", "badge-outline", "badge-dash", "badge-soft", "badge-ghost", "badge-primary", "badge-secondary",
-			"badge-accent", "badge-neutral", "badge-info", "badge-success", "badge-warning", "badge-error", "badge-xs",
-			"badge-sm", "badge-md", "badge-lg", "badge-xl",
-
-			// daisyUI Card
-			"card", "card-title", "card-body", "card-actions", "card-border", "card-dash", "card-side", "image-full", "card-xs",
-			"card-sm", "card-md", "card-lg", "card-xl",
-
-			// daisyUI Carousel
-			"carousel", "carousel-item", "carousel-start", "carousel-center", "carousel-end", "carousel-horizontal", "carousel-vertical",
-
-			// daisyUI Chat Bubble
-			"chat", "chat-image", "chat-header", "chat-footer", "chat-bubble", "chat-start", "chat-end",
-			"chat-bubble-primary", "chat-bubble-secondary", "chat-bubble-accent", "chat-bubble-neutral", "chat-bubble-info",
-			"chat-bubble-success", "chat-bubble-warning", "chat-bubble-error",
-
-			// daisyUI Countdown
-			"countdown",
-
-			// daisyUI Diff
-			"diff", "diff-item-1", "diff-item-2", "diff-resizer",
-
-			// daisyUI Hover Gallery
-			"hover-gallery",
+		if err := config.merge(userConfig); err != nil {
+			return nil, fmt.Errorf("invalid config file %s: %w", configFile, err)
+		}
+	}
 
-			// daisyUI KBD
-			"kbd", "kbd-xs", "kbd-sm", "kbd-md", "kbd-lg", "kbd-xl",
+	if cssEntry != "" {
+		config.CSSEntry = cssEntry
+	}
 
-			// daisyUI List
-			"list", "list-row", "list-col-wrap", "list-col-grow",
+	// A Tailwind config found in the working directory is only read if it
+	// can be, unlike one given in the config file.
+	explicitTailwindConfig := config.TailwindConfig != ""
+	if config.TailwindConfig == "" && config.CSSEntry == "" {
+		for _, fileName := range tailwindConfigFileNames {
+			if _, err := os.Stat(fileName); err == nil {
+				config.TailwindConfig = fileName
+				break
+			}
+		}
+	}
 
-			// daisyUI Stat
-			"stats", "stat", "stat-title", "stat-value", "stat-desc", "stat-figure", "stat-actions", "stats-horizontal",
-			"stats-vertical",
+	if config.TailwindConfig != "" {
+		if err := config.applyTailwindConfig(config.TailwindConfig); err != nil {
+			if explicitTailwindConfig {
+				return nil, fmt.Errorf("failed to read Tailwind config %s: %w", config.TailwindConfig, err)
+			}
+			config.Warnings = append(config.Warnings, fmt.Sprintf("failed to read Tailwind config %s: %v", config.TailwindConfig, err))
+		}
+	}
 
-			// daisyUI Status
-			"status", "status-primary", "status-secondary", "status-accent", "status-neutral", "status-info", "status-success",
-			"status-warning", "status-error", "status-xs", "status-sm", "status-md", "status-lg", "status-xl",
+	if config.CSSEntry != "" {
+		if err := config.applyCSSEntry(config.CSSEntry); err != nil {
+			return nil, fmt.Errorf("failed to read CSS entry %s: %w", config.CSSEntry, err)
+		}
+	}
 
-			// daisyUI Table
-			"table", "table-zebra", "table-pin-rows", "table-pin-cols", "table-xs", "table-sm", "table-md", "table-lg", "table-xl",
+	if config.OrderFromCSS != "" {
+		cssOrder, err := readCSSOrder(config.OrderFromCSS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read class order from %s: %w", config.OrderFromCSS, err)
+		}
+		config.CSSOrder = cssOrder
+	}
 
-			// daisyUI Timeline
-			"timeline", "timeline-start", "timeline-middle", "timeline-end", "timeline-snap-icon", "timeline-box",
-			"timeline-compact", "timeline-horizontal", "timeline-vertical",
+	config.applyPlugins()
 
-			// daisyUI Breadcrumbs
-			"breadcrumbs",
+	if userConfig != nil {
+		if err := config.mergeOrder(userConfig); err != nil {
+			return nil, fmt.Errorf("invalid config file %s: %w", configFile, err)
+		}
+	}
 
-			// daisyUI Dock
-			"dock", "dock-label", "dock-active", "dock-xs", "dock-sm", "dock-md", "dock-lg", "dock-xl",
+	config.applyDaisyUIPrefix()
 
-			// daisyUI Link
-			"link", "link-hover", "link-primary", "link-secondary", "link-accent", "link-neutral", "link-success",
-			"link-info", "link-warning", "link-error",
+	return config, nil
+}
 
-			// daisyUI Menu
-			"menu", "menu-title", "menu-dropdown", "menu-dropdown-toggle", "menu-disabled", "menu-active", "menu-focus",
-			"menu-dropdown-show", "menu-xs", "menu-sm", "menu-md", "menu-lg", "menu-xl", "menu-horizontal", "menu-vertical",
+// applyDaisyUIPrefix prefixes the daisyUI component names once every source
+// of configuration has been read, e.g. `btn-*` becomes `d-btn-*`.
+func (config *Config) applyDaisyUIPrefix() {
+	if config.DaisyUI.Prefix == "" {
+		return
+	}
 
-			// daisyUI Navbar
-			"navbar", "navbar-start", "navbar-center", "navbar-end",
+	for idx, entry := range config.ClassOrder {
+		if _, ok := config.daisyUIEntries[entry]; ok {
+			config.ClassOrder[idx] = config.DaisyUI.Prefix + entry
+		}
+	}
+}
 
-			// daisyUI Pagination / Join
-			"join", "join-item", "join-horizontal", "join-vertical",
+func defaultConfig() *Config {
+	config := &Config{
+		Theme: Theme{
+			Breakpoints: map[string]string{"sm": "40rem", "md": "48rem", "lg": "64rem", "xl": "80rem", "2xl": "96rem"},
+			Containers: map[string]string{
+				"3xs": "16rem", "2xs": "18rem", "xs": "20rem", "sm": "24rem", "md": "28rem", "lg": "32rem", "xl": "36rem",
+				"2xl": "42rem", "3xl": "48rem", "4xl": "56rem", "5xl": "64rem", "6xl": "72rem", "7xl": "80rem",
+			},
+			Colors: map[string]string{},
+		},
+		CustomVariants:      map[string]struct{}{},
+		PropertyOrder:       defaultPropertyOrder(),
+		StaticUtilities:     defaultStaticUtilities(),
+		FunctionalUtilities: defaultFunctionalUtilities(),
+		ValueUtilities:      defaultValueUtilities(),
+		ModifierUtilities:   defaultModifierUtilities(),
+		Separator:           ":",
+		UnknownClasses:      PositionEnd,
+		FilePatterns:        []string{".html"},
+		ClassAttributes:     []string{"class"},
+		HTMLFilePatterns:    []string{".html", ".htm"},
+		JSXFilePatterns:     []string{".jsx", ".tsx"},
+		VueFilePatterns:     []string{".vue"},
+	}
+	// The default presets are known to compose.
+	if err := config.applyPresets(defaultPresets); err != nil {
+		panic(err)
+	}
 
-			// daisyUI Steps
-			"steps", "step", "step-icon", "step-primary", "step-secondary", "step-accent", "step-neutral", "step-info",
-			"step-success", "step-warning", "step-error", "step-horizontal", "step-vertical",
+	return config
+}
 
-			// daisyUI Tabs
-			"tabs", "tab", "tab-content", "tabs-box", "tabs-border", "tabs-lift", "tab-active", "tab-disabled", "tabs-top",
-			"tabs-bottom", "tabs-xs", "tabs-sm", "tabs-md", "tabs-lg", "tabs-xl",
+func (config *Config) merge(userConfig *UserConfig) error {
+	if len(userConfig.FilePatterns) > 0 {
+		config.FilePatterns = userConfig.FilePatterns
+	}
 
-			// daisyUI Alert
-			"alert", "alert-outline", "alert-dash", "alert-soft", "alert-ghost", "alert-info", "alert-success",
-			"alert-warning", "alert-error", "alert-horizontal", "alert-vertical",
+	if len(userConfig.ClassAttributes) > 0 {
+		config.ClassAttributes = userConfig.ClassAttributes
+	}
 
-			// daisyUI Loading
-			"loading", "loading-spinner", "loading-dots", "loading-ring", "loading-ball", "loading-bars", "loading-infinity",
-			"loading-xs", "loading-sm", "loading-md", "loading-lg", "loading-xl",
+	if len(userConfig.HTMLFilePatterns) > 0 {
+		config.HTMLFilePatterns = userConfig.HTMLFilePatterns
+	}
 
-			// daisyUI Progress
-			"progress", "progress-primary", "progress-secondary", "progress-accent", "progress-neutral", "progress-info",
-			"progress-success", "progress-warning", "progress-error",
+	if len(userConfig.JSXFilePatterns) > 0 {
+		config.JSXFilePatterns = userConfig.JSXFilePatterns
+	}
 
-			// daisyUI Radial Progress
-			"radial-progress",
+	if len(userConfig.VueFilePatterns) > 0 {
+		config.VueFilePatterns = userConfig.VueFilePatterns
+	}
 
-			// daisyUI Toast
-			"toast", "toast-start", "toast-center", "toast-end", "toast-top", "toast-middle", "toast-bottom",
+	if userConfig.ScanComments {
+		config.ScanComments = true
+	}
 
-			// daisyUI Tooltip
-			"tooltip", "tooltip-content", "tooltip-top", "tooltip-bottom", "tooltip-left", "tooltip-right", "tooltip-open",
-			"tooltip-primary", "tooltip-secondary", "tooltip-accent", "tooltip-neutral", "tooltip-info", "tooltip-success",
-			"tooltip-warning", "tooltip-error",
+	if len(userConfig.ClassFunctions) > 0 {
+		config.ClassFunctions = userConfig.ClassFunctions
+	}
 
-			// daisyUI Calendar
-			"cally", "pika-single", "react-day-picker",
+	if userConfig.PreserveWhitespace {
+		config.PreserveWhitespace = true
+	}
 
-			// daisyUI Checkbox
-			"checkbox", "checkbox-primary", "checkbox-secondary", "checkbox-accent", "checkbox-neutral", "checkbox-info",
-			"checkbox-success", "checkbox-warning", "checkbox-error", "checkbox-xs", "checkbox-sm", "checkbox-md", "checkbox-lg",
-			"checkbox-xl",
+	if len(userConfig.ScanRawText) > 0 {
+		config.ScanRawText = userConfig.ScanRawText
+	}
 
-			// daisyUI Fieldset
-			"fieldset", "fieldset-legend",
+	if userConfig.OrderFromCSS != "" {
+		config.OrderFromCSS = userConfig.OrderFromCSS
+	}
 
-			// daisyUI File Input
-			"file-input", "file-input-ghost", "file-input-primary", "file-input-secondary", "file-input-accent",
-			"file-input-neutral", "file-input-info", "file-input-success", "file-input-warning", "file-input-error",
-			"file-input-xs", "file-input-sm", "file-input-md", "file-input-lg", "file-input-xl",
+	if userConfig.CSSEntry != "" {
+		config.CSSEntry = userConfig.CSSEntry
+	}
 
-			// daisyUI Field Filter
-			"filter", "filter-reset",
+	if userConfig.TailwindConfig != "" {
+		config.TailwindConfig = userConfig.TailwindConfig
+	}
 
-			// daisyUI Label
-			"label", "floating-label",
+	if userConfig.TailwindPrefix != "" {
+		config.Prefix = userConfig.TailwindPrefix
+	}
 
-			// daisyUI Radio
-			"radio", "radio-primary", "radio-secondary", "radio-accent", "radio-neutral", "radio-info", "radio-success",
-			"radio-warning", "radio-error", "radio-xs", "radio-sm", "radio-md", "radio-lg", "radio-xl",
+	if userConfig.DaisyUIPrefix != "" {
+		config.DaisyUI.Prefix = userConfig.DaisyUIPrefix
+	}
 
-			// daisyUI Range Slider
-			"range", "range-primary", "range-secondary", "range-accent", "range-neutral", "range-info", "range-success",
-			"range-warning", "range-error", "range-xs", "range-sm", "range-md", "range-lg", "range-xl",
+	if len(userConfig.Presets) > 0 {
+		if err := config.applyPresets(userConfig.Presets); err != nil {
+			return fmt.Errorf("presets: %w", err)
+		}
+	}
 
-			// daisyUI Rating
-			"rating", "rating-half", "rating-hidden", "rating-xs", "rating-sm", "rating-md", "rating-lg", "rating-xl",
+	switch userConfig.VariantSemantics {
+	case "":
+	case VariantSemanticsV3, VariantSemanticsV4:
+		config.VariantSemantics = userConfig.VariantSemantics
+	default:
+		return fmt.Errorf("variant_semantics: expected %q or %q, got %q", VariantSemanticsV3, VariantSemanticsV4, userConfig.VariantSemantics)
+	}
 
-			// daisyUI Select
-			"select", "select-ghost", "select-primary", "select-secondary", "select-accent", "select-neutral", "select-info",
-			"select-success", "select-warning", "select-error", "select-xs", "select-sm", "select-md", "select-lg", "select-xl",
+	switch userConfig.UnknownClasses {
+	case "":
+	case PositionStart, PositionEnd, PositionPreserve:
+		config.UnknownClasses = userConfig.UnknownClasses
+	default:
+		return fmt.Errorf("unknown_classes: expected %q, %q or %q, got %q", PositionStart, PositionEnd, PositionPreserve, userConfig.UnknownClasses)
+	}
 
-			// daisyUI Text Input
-			"input", "input-ghost", "input-primary", "input-secondary", "input-accent", "input-neutral", "input-info",
-			"input-success", "input-warning", "input-error", "input-xs", "input-sm", "input-md", "input-lg", "input-xl",
+	if len(userConfig.ClassOrder) > 0 {
+		config.ClassOrder = slices.Clone(userConfig.ClassOrder)
+	}
 
-			// daisyUI Textarea
-			"textarea", "textarea-ghost", "textarea-primary", "textarea-secondary", "textarea-accent", "textarea-neutral",
-			"textarea-info", "textarea-success", "textarea-warning", "textarea-error", "textarea-xs", "textarea-sm",
-			"textarea-md", "textarea-lg", "textarea-xl",
-
-			// daisyUI Toggle
-			"toggle", "toggle-primary", "toggle-secondary", "toggle-accent", "toggle-neutral", "toggle-info", "toggle-success",
-			"toggle-warning", "toggle-error", "toggle-xs", "toggle-sm", "toggle-md", "toggle-lg", "toggle-xl",
-
-			// daisyUI Validator
-			"validator", "validator-hint",
-
-			// daisyUI Divider
-			"divider", "divider-primary", "divider-secondary", "divider-accent", "divider-neutral", "divider-info",
-			"divider-success", "divider-warning", "divider-error", "divider-start", "divider-end", "divider-horizontal",
-			"divider-vertical",
-
-			// daisyUI Drawer
-			"drawer", "drawer-toggle", "drawer-content", "drawer-side", "drawer-overlay", "drawer-end", "drawer-open",
-			"is-drawer-open:", "is-drawer-close:",
-
-			// daisyUI Footer
-			"footer", "footer-title", "footer-center", "footer-horizontal", "footer-vertical",
-
-			// daisyUI Hero
-			"hero", "hero-content", "hero-overlay",
-
-			// daisyUI Indicator
-			"indicator", "indicator-item", "indicator-start", "indicator-center", "indicator-end", "indicator-top",
-			"indicator-middle", "indicator-bottom",
-
-			// daisyUI Mask
-			"mask", "mask-squircle", "mask-heart", "mask-hexagon", "mask-hexagon-2", "mask-decagon", "mask-pentagon",
-			"mask-diamond", "mask-square", "mask-circle", "mask-star", "mask-star-2", "mask-triangle", "mask-triangle-2",
-			"mask-triangle-3", "mask-triangle-4", "mask-half-1", "mask-half-2",
+	return nil
+}
 
-			// daisyUI Stack
-			"stack", "stack-top", "stack-bottom", "stack-start", "stack-end",
+// mergeOrder applies the user order options once every other source of
+// configuration has been read, so that they may be anchored to the utilities
+// and variants of the CSS entry and the breakpoints they rank are not cleared
+// by the theme.
+func (config *Config) mergeOrder(userConfig *UserConfig) error {
+	if err := config.mergeClassOrder(userConfig); err != nil {
+		return err
+	}
 
-			// daisyUI Browser
-			"mockup-browser", "mockup-browser-toolbar",
-
-			// daisyUI Code
-			"mockup-code",
-
-			// daisyUI Phone
-			"mockup-phone", "mockup-phone-camera", "mockup-phone-display",
+	if err := config.mergeCustomGroups(userConfig.CustomGroups); err != nil {
+		return err
+	}
 
-			// daisyUI Window
-			"mockup-window",
+	if err := config.validateClassOrder(); err != nil {
+		return err
+	}
 
-			// daisyUI Background
-			"bg-primary", "bg-secondary", "bg-accent", "bg-neutral", "bg-info", "bg-success", "bg-warning", "bg-error",
-
-			// daisyUI Color Utility
-			"to-primary", "to-secondary", "to-accent", "to-neutral", "to-info", "to-success", "to-warning", "to-error",
-			"via-primary", "via-secondary", "via-accent", "via-neutral", "via-info", "via-success", "via-warning", "via-error",
-			"from-primary", "from-secondary", "from-accent", "from-neutral", "from-info", "from-success", "from-warning", "from-error",
-			"ring-primary", "ring-secondary", "ring-accent", "ring-neutral", "ring-info", "ring-success", "ring-warning", "ring-error",
-			"fill-primary", "fill-secondary", "fill-accent", "fill-neutral", "fill-info", "fill-success", "fill-warning", "fill-error",
-			"caret-primary", "caret-secondary", "caret-accent", "caret-neutral", "caret-info", "caret-success", "caret-warning", "caret-error",
-			"stroke-primary", "stroke-secondary", "stroke-accent", "stroke-neutral", "stroke-info", "stroke-success", "stroke-warning", "stroke-error",
-			"border-primary", "border-secondary", "border-accent", "border-neutral", "border-info", "border-success", "border-warning", "border-error",
-			"divide-primary", "divide-secondary", "divide-accent", "divide-neutral", "divide-info", "divide-success", "divide-warning", "divide-error",
-			"accent-primary", "accent-secondary", "accent-accent", "accent-neutral", "accent-info", "accent-success", "accent-warning", "accent-error",
-			"shadow-primary", "shadow-secondary", "shadow-accent", "shadow-neutral", "shadow-info", "shadow-success", "shadow-warning", "shadow-error",
-			"outline-primary", "outline-secondary", "outline-accent", "outline-neutral", "outline-info", "outline-success", "outline-warning", "outline-error",
-			"decoration-primary", "decoration-secondary", "decoration-accent", "decoration-neutral", "decoration-info", "decoration-success", "decoration-warning", "decoration-error",
-			"placeholder-primary", "placeholder-secondary", "placeholder-accent", "placeholder-neutral", "placeholder-info", "placeholder-success", "placeholder-warning", "placeholder-error",
-			"ring-offset-primary", "ring-offset-secondary", "ring-offset-accent", "ring-offset-neutral", "ring-offset-info", "ring-offset-success", "ring-offset-warning", "ring-offset-error",
-			"rounded-box", "rounded-field", "rounded-selector",
-			"glass",
-
-			// daisyUI Foreground
-			"text-primary", "text-secondary", "text-accent", "text-neutral", "text-info", "text-success", "text-warning", "text-error",
-
-			// daisyUI Theme Controller
-			"theme-controller",
-
-			// Layout (Box Sizing, Display, Floats, Clear, Isolation, Object Fit/Position, Overflow, Overscroll, Position, Visibility, Z-Index)
-			"box-border", "box-content", "block", "inline-block", "inline", "flex", "inline-flex", "table", "inline-table",
-			"table-caption", "table-cell", "table-column", "table-column-group", "table-footer-group", "table-header-group",
-			"table-row-group", "table-row", "flow-root", "grid", "inline-grid", "contents", "list-item", "hidden", "float-",
-			"clear-", "isolate", "isolation-auto", "object-", "overflow-", "overscroll-", "static", "fixed", "absolute",
-			"relative", "sticky", "top-", "right-", "bottom-", "left-", "inset-", "visible", "invisible", "z-",
-
-			// Flexbox & Grid
-			"flex-basis-", "flex-direction-", "flex-wrap-", "flex-", "flex-grow", "flex-shrink", "order-", "grid-cols-",
-			"grid-col-", "grid-rows-", "grid-row-", "grid-flow-", "gap-", "justify-", "justify-items-", "justify-self-",
-			"items-", "align-", "place-content-", "place-items-", "place-self-",
-
-			// Spacing (Padding, Margin, Space Between)
-			"p-", "px-", "py-", "pt-", "pr-", "pb-", "pl-", "m-", "mx-", "my-", "mt-", "mr-", "mb-", "ml-", "space-",
-
-			// Sizing (Width, Min-Width, Max-Width, Height, Min-Height, Max-Height)
-			"w-", "min-w-", "max-w-", "h-", "min-h-", "max-h-",
-
-			// Typography
-			"font-", "text-", "italic", "not-italic", "font-weight-", "font-variant-numeric-", "letter-spacing-",
-			"line-clamp-", "line-height-", "list-", "text-align-", "text-color-", "text-decoration-",
-			"text-decoration-color-", "text-decoration-style-", "text-decoration-thickness-", "text-underline-offset-",
-			"text-transform-", "text-overflow-", "text-indent-", "vertical-align-", "whitespace-", "break-",
-			"content-",
-
-			// Backgrounds
-			"bg-", "bg-opacity-", "bg-origin-", "bg-position-", "bg-repeat-", "bg-size-", "bg-image-", "gradient-to-",
-			"from-", "via-", "to-",
-
-			// Borders
-			"rounded-", "border", "border-", "border-opacity-", "border-style-", "divide-", "divide-opacity-",
-			"divide-style-", "outline-", "outline-offset-", "outline-style-", "ring-", "ring-offset-", "ring-opacity-",
-
-			// Effects (Box Shadow, Opacity, Mix Blend, Background Blend)
-			"shadow-", "opacity-", "mix-blend-", "bg-blend-",
-
-			// Filters (Blur, Brightness, Contrast, Drop Shadow, Grayscale, Hue Rotate, Invert, Saturate, Sepia, Backdrop)
-			"filter", "blur-", "brightness-", "contrast-", "drop-shadow-", "grayscale-", "hue-rotate-", "invert-",
-			"saturate-", "sepia-", "backdrop-",
-
-			// Tables
-			"border-collapse", "border-spacing-", "table-layout-", "caption-side-",
-
-			// Transitions & Animation
-			"transition", "duration-", "ease-", "delay-", "animate-",
-
-			// Transforms
-			"transform", "scale-", "rotate-", "translate-", "skew-", "transform-origin-",
-
-			// Interactivity
-			"accent-", "appearance-", "cursor-", "caret-", "pointer-events-", "resize", "scroll-", "scroll-snap-",
-			"touch-", "select-", "will-change-",
-
-			// SVG
-			"fill-", "stroke-", "stroke-width-",
-
-			// Screen Readers
-			"sr-only", "not-sr-only",
-		},
-		VariantOrder: map[string]int{
-			"sm": 0, "md": 1, "lg": 2, "xl": 3, "2xl": 4, "dark": 10,
-			"motion-safe": 20, "motion-reduce": 21, "portrait": 22, "landscape": 23,
-			"first": 30, "last": 31, "odd": 32, "even": 33, "visited": 34, "checked": 35,
-			"disabled": 36, "enabled": 37, "hover": 40, "focus": 41, "focus-within": 42,
-			"focus-visible": 43, "active": 44,
-		},
-		FilePatterns:    []string{".html"},
-		ClassAttributes: []string{"class"},
+	if err := config.mergeVariantOrder(userConfig.VariantOrder); err != nil {
+		return fmt.Errorf("variant_order: %w", err)
 	}
+
+	return nil
 }
 
-func (config *Config) merge(userConfig *UserConfig) {
-	if len(userConfig.FilePatterns) > 0 {
-		config.FilePatterns = userConfig.FilePatterns
+// resolveConfigPath makes paths given in the config file relative to the
+// directory of the config file rather than the working directory.
+func resolveConfigPath(configFile, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
 	}
 
-	if len(userConfig.ClassAttributes) > 0 {
-		config.ClassAttributes = userConfig.ClassAttributes
-	}
+	return filepath.Join(filepath.Dir(configFile), path)
 }
@@ -0,0 +1,242 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// cssNode is either a statement (`color: red;`, `@import "tailwindcss";`) or,
+// when Block is set, a rule or at-rule with its nested nodes.
+type cssNode struct {
+	Prelude  string
+	Block    bool
+	Children []cssNode
+}
+
+func parseCSS(content string) []cssNode {
+	nodes, _ := parseCSSBlock(stripCSSComments(content), 0)
+	return nodes
+}
+
+func parseCSSBlock(content string, start int) ([]cssNode, int) {
+	var nodes []cssNode
+	var prelude strings.Builder
+
+	parenLevel := 0
+	for idx := start; idx < len(content); idx++ {
+		char := content[idx]
+
+		switch {
+		case char == '"' || char == '\'':
+			end := skipCSSString(content, idx)
+			prelude.WriteString(content[idx:end])
+			idx = end - 1
+		case char == '\\' && idx+1 < len(content):
+			prelude.WriteString(content[idx : idx+2])
+			idx++
+		case char == '(':
+			parenLevel++
+			prelude.WriteByte(char)
+		case char == ')':
+			parenLevel--
+			prelude.WriteByte(char)
+		case char == ';' && parenLevel == 0:
+			if statement := strings.TrimSpace(prelude.String()); statement != "" {
+				nodes = append(nodes, cssNode{Prelude: statement})
+			}
+			prelude.Reset()
+		case char == '{' && parenLevel == 0:
+			children, end := parseCSSBlock(content, idx+1)
+			nodes = append(nodes, cssNode{Prelude: strings.TrimSpace(prelude.String()), Block: true, Children: children})
+			prelude.Reset()
+			idx = end
+		case char == '}' && parenLevel == 0:
+			if statement := strings.TrimSpace(prelude.String()); statement != "" {
+				nodes = append(nodes, cssNode{Prelude: statement})
+			}
+			return nodes, idx
+		default:
+			prelude.WriteByte(char)
+		}
+	}
+
+	if statement := strings.TrimSpace(prelude.String()); statement != "" {
+		nodes = append(nodes, cssNode{Prelude: statement})
+	}
+
+	return nodes, len(content)
+}
+
+func skipCSSString(content string, start int) int {
+	quote := content[start]
+	for idx := start + 1; idx < len(content); idx++ {
+		switch content[idx] {
+		case '\\':
+			idx++
+		case quote:
+			return idx + 1
+		}
+	}
+
+	return len(content)
+}
+
+func stripCSSComments(content string) string {
+	var result strings.Builder
+	result.Grow(len(content))
+
+	for idx := 0; idx < len(content); idx++ {
+		switch {
+		case content[idx] == '"' || content[idx] == '\'':
+			end := skipCSSString(content, idx)
+			result.WriteString(content[idx:end])
+			idx = end - 1
+		case strings.HasPrefix(content[idx:], "/*"):
+			end := strings.Index(content[idx+2:], "*/")
+			if end == -1 {
+				return result.String()
+			}
+			idx += end + 3
+		default:
+			result.WriteByte(content[idx])
+		}
+	}
+
+	return result.String()
+}
+
+// readCSSOrder ranks every class selector of a compiled stylesheet by the
+// position of the first rule that uses it. Tailwind emits its rules in the
+// canonical order, so this is the order the class strings should follow.
+func readCSSOrder(path string) (map[string]int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cssOrder := make(map[string]int)
+	collectCSSOrder(parseCSS(string(content)), cssOrder)
+
+	if len(cssOrder) == 0 {
+		return nil, fmt.Errorf("no class selectors found")
+	}
+
+	return cssOrder, nil
+}
+
+func collectCSSOrder(nodes []cssNode, cssOrder map[string]int) {
+	for _, node := range nodes {
+		if !node.Block {
+			continue
+		}
+
+		if strings.HasPrefix(node.Prelude, "@") {
+			switch atRuleName(node.Prelude) {
+			case "keyframes", "font-face", "property", "counter-style", "page":
+				continue
+			}
+		} else {
+			for _, className := range selectorClasses(node.Prelude) {
+				if _, exists := cssOrder[className]; !exists {
+					cssOrder[className] = len(cssOrder)
+				}
+			}
+		}
+
+		collectCSSOrder(node.Children, cssOrder)
+	}
+}
+
+func atRuleName(prelude string) string {
+	name := strings.TrimPrefix(prelude, "@")
+	if end := strings.IndexAny(name, " \t\r\n({\"'"); end != -1 {
+		name = name[:end]
+	}
+
+	return name
+}
+
+// selectorClasses returns the class each complex selector of a selector list
+// styles: the last class outside of any pseudo-class arguments, so
+// `.group:hover .group-hover\:flex` yields `group-hover:flex` and
+// `.peer-checked\:flex:is(:where(.peer):checked ~ *)` yields `peer-checked:flex`.
+func selectorClasses(selectorList string) []string {
+	var classes []string
+
+	lastClass := ""
+	parenLevel := 0
+	for idx := 0; idx < len(selectorList); idx++ {
+		switch char := selectorList[idx]; {
+		case char == '\\':
+			idx++
+		case char == '"' || char == '\'':
+			idx = skipCSSString(selectorList, idx) - 1
+		case char == '(' || char == '[':
+			parenLevel++
+		case char == ')' || char == ']':
+			parenLevel--
+		case char == ',' && parenLevel == 0:
+			if lastClass != "" {
+				classes = append(classes, lastClass)
+			}
+			lastClass = ""
+		case char == '.' && parenLevel == 0:
+			className, end := readCSSIdentifier(selectorList, idx+1)
+			if className != "" {
+				lastClass = className
+			}
+			idx = end - 1
+		}
+	}
+
+	if lastClass != "" {
+		classes = append(classes, lastClass)
+	}
+
+	return classes
+}
+
+// readCSSIdentifier reads and unescapes the identifier starting at start.
+func readCSSIdentifier(content string, start int) (string, int) {
+	var identifier strings.Builder
+
+	idx := start
+	for idx < len(content) {
+		char := content[idx]
+
+		switch {
+		case char == '\\' && idx+1 < len(content):
+			hexEnd := idx + 1
+			for hexEnd < len(content) && hexEnd < idx+7 && isHexDigit(content[hexEnd]) {
+				hexEnd++
+			}
+
+			if hexEnd == idx+1 {
+				identifier.WriteByte(content[idx+1])
+				idx += 2
+				continue
+			}
+
+			codePoint, _ := strconv.ParseInt(content[idx+1:hexEnd], 16, 32)
+			identifier.WriteRune(rune(codePoint))
+			idx = hexEnd
+			if idx < len(content) && content[idx] == ' ' {
+				idx++
+			}
+		case char == '-' || char == '_' || char >= 0x80 ||
+			(char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9'):
+			identifier.WriteByte(char)
+			idx++
+		default:
+			return identifier.String(), idx
+		}
+	}
+
+	return identifier.String(), idx
+}
+
+func isHexDigit(char byte) bool {
+	return (char >= '0' && char <= '9') || (char >= 'a' && char <= 'f') || (char >= 'A' && char <= 'F')
+}
@@ -0,0 +1,350 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// applyCSSEntry reads a Tailwind CSS v4 entry file and extends the
+// configuration with the theme, custom utilities, custom variants and
+// daisyUI plugin options declared in it.
+func (config *Config) applyCSSEntry(path string) error {
+	nodes, err := readCSSEntry(path, make(map[string]struct{}))
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if !strings.HasPrefix(node.Prelude, "@") {
+			continue
+		}
+
+		switch atRuleName(node.Prelude) {
+		case "import":
+			// `@import "tailwindcss" prefix(tw);` prefixes every class as a variant, e.g. `tw:flex`.
+			if _, params, found := strings.Cut(atRuleParams(node.Prelude), "prefix("); found {
+				if prefix, _, found := strings.Cut(params, ")"); found && strings.TrimSpace(prefix) != "" {
+					config.Prefix = strings.TrimSpace(prefix) + ":"
+				}
+			}
+		case "theme":
+			config.applyTheme(node.Children)
+		case "utility":
+			config.addCustomUtility(atRuleParams(node.Prelude), node.Children)
+		case "custom-variant", "variant":
+			config.addCustomVariant(atRuleParams(node.Prelude))
+		case "plugin":
+			config.applyPlugin(unquoteCSS(atRuleParams(node.Prelude)), node.Children)
+		case "config":
+			// Tailwind CSS v4 can still load a legacy JavaScript config.
+			tailwindConfig := filepath.Join(filepath.Dir(path), unquoteCSS(atRuleParams(node.Prelude)))
+			if err := config.applyTailwindConfig(tailwindConfig); err != nil {
+				return fmt.Errorf("failed to read Tailwind config %s: %w", tailwindConfig, err)
+			}
+		}
+	}
+
+	config.rankBreakpoints()
+
+	return nil
+}
+
+// readCSSEntry parses the file and inlines the local stylesheets it imports.
+func readCSSEntry(path string, seen map[string]struct{}) ([]cssNode, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := seen[absPath]; exists {
+		return nil, nil
+	}
+	seen[absPath] = struct{}{}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []cssNode
+	for _, node := range parseCSS(string(content)) {
+		if node.Block || atRuleName(node.Prelude) != "import" || !strings.HasPrefix(node.Prelude, "@") {
+			nodes = append(nodes, node)
+			continue
+		}
+
+		importPath := unquoteCSS(strings.Fields(atRuleParams(node.Prelude) + " ")[0])
+		if !strings.HasPrefix(importPath, "./") && !strings.HasPrefix(importPath, "../") {
+			nodes = append(nodes, node)
+			continue
+		}
+
+		importedNodes, err := readCSSEntry(filepath.Join(filepath.Dir(path), importPath), seen)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import %s: %w", importPath, err)
+		}
+		nodes = append(nodes, importedNodes...)
+	}
+
+	return nodes, nil
+}
+
+func (config *Config) applyTheme(declarations []cssNode) {
+	for _, declaration := range declarations {
+		name, value, ok := splitCSSDeclaration(declaration)
+		if !ok || !strings.HasPrefix(name, "--") {
+			continue
+		}
+
+		if name == "--*" && value == "initial" {
+			clear(config.Theme.Breakpoints)
+			clear(config.Theme.Containers)
+			clear(config.Theme.Colors)
+			continue
+		}
+
+		for namespace, values := range map[string]map[string]string{
+			"--breakpoint-": config.Theme.Breakpoints,
+			"--container-":  config.Theme.Containers,
+			"--color-":      config.Theme.Colors,
+		} {
+			key, found := strings.CutPrefix(name, namespace)
+			if !found {
+				continue
+			}
+
+			if key == "*" && value == "initial" {
+				clear(values)
+			} else {
+				values[key] = value
+			}
+		}
+	}
+}
+
+// addCustomUtility registers an `@utility` by the properties it declares, or
+// appends it to the class order when it only applies other classes.
+func (config *Config) addCustomUtility(name string, children []cssNode) {
+	if name == "" {
+		return
+	}
+
+	var properties []string
+	for _, child := range children {
+		if property, _, ok := splitCSSDeclaration(child); ok && !slices.Contains(properties, property) {
+			properties = append(properties, property)
+		}
+	}
+
+	root, functional := strings.CutSuffix(name, "-*")
+	switch {
+	case len(properties) > 0 && functional:
+		config.FunctionalUtilities[root] = properties
+	case len(properties) > 0:
+		config.StaticUtilities[name] = properties
+	case functional && !slices.Contains(config.ClassOrder, root+"-*"):
+		config.ClassOrder = append(config.ClassOrder, root+"-*")
+	case !functional && !slices.Contains(config.ClassOrder, name):
+		config.ClassOrder = append(config.ClassOrder, name)
+	}
+}
+
+// addCustomVariant ranks a custom variant after every variant known so far,
+// which is where Tailwind registers them too.
+func (config *Config) addCustomVariant(params string) {
+	fields := strings.Fields(params)
+	if len(fields) == 0 {
+		return
+	}
+
+	name := strings.TrimRight(fields[0], "({")
+	if _, exists := config.VariantOrder[name]; exists || name == "" {
+		return
+	}
+	config.CustomVariants[name] = struct{}{}
+
+	nextOrder := 0
+	for _, order := range config.VariantOrder {
+		nextOrder = max(nextOrder, order+variantOrderStep)
+	}
+	config.VariantOrder[name] = nextOrder
+}
+
+func (config *Config) applyPlugin(name string, options []cssNode) {
+	config.Plugins = append(config.Plugins, name)
+	if !isDaisyUIPlugin(name) {
+		return
+	}
+
+	for _, option := range options {
+		key, value, ok := splitCSSDeclaration(option)
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "prefix":
+			config.DaisyUI.Prefix = unquoteCSS(value)
+		case "include":
+			config.DaisyUI.Include = splitCSSList(value)
+		case "exclude":
+			config.DaisyUI.Exclude = splitCSSList(value)
+		}
+	}
+
+	// Only the daisyUI components that are included are ranked, and the
+	// excluded ones never are. Other entries, such as Tailwind's `table-*`,
+	// are kept.
+	config.ClassOrder = slices.DeleteFunc(config.ClassOrder, func(entry string) bool {
+		if _, ok := config.daisyUIEntries[entry]; !ok {
+			return false
+		}
+		if len(config.DaisyUI.Include) > 0 && !isDaisyUIComponentEntry(entry, config.DaisyUI.Include) {
+			return true
+		}
+		return isDaisyUIComponentEntry(entry, config.DaisyUI.Exclude)
+	})
+}
+
+// isDaisyUIComponentEntry tells whether a class order entry belongs to one of
+// the given daisyUI components, e.g. `btn-*` to `button`.
+func isDaisyUIComponentEntry(entry string, components []string) bool {
+	return slices.ContainsFunc(components, func(component string) bool {
+		classes, ok := daisyUIComponentClasses[component]
+		if !ok {
+			classes = []string{component}
+		}
+		return slices.ContainsFunc(classes, func(class string) bool {
+			return entry == class || strings.HasPrefix(entry, class+"-")
+		})
+	})
+}
+
+// rankBreakpoints gives the breakpoint variants consecutive ranks right after
+// `min-*`, ordered by their width. Breakpoints whose width cannot be read keep
+// the order of their names after the others.
+func (config *Config) rankBreakpoints() {
+	minOrder := config.VariantOrder["min-*"]
+	for name, order := range config.VariantOrder {
+		if order > minOrder && order < minOrder+variantOrderStep {
+			delete(config.VariantOrder, name)
+		}
+	}
+
+	names := make([]string, 0, len(config.Theme.Breakpoints))
+	for name := range config.Theme.Breakpoints {
+		names = append(names, name)
+	}
+
+	slices.SortFunc(names, func(a, b string) int {
+		widthA, okA := parseCSSLength(config.Theme.Breakpoints[a])
+		widthB, okB := parseCSSLength(config.Theme.Breakpoints[b])
+		switch {
+		case okA && okB && widthA != widthB:
+			if widthA < widthB {
+				return -1
+			}
+			return 1
+		case okA != okB:
+			if okA {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+
+	for idx, name := range names {
+		config.VariantOrder[name] = minOrder + min(idx+1, variantOrderStep-1)
+	}
+}
+
+// ScreenWidth returns the width in pixels of a breakpoint, such as `md`, or of
+// an arbitrary value, such as `[900px]`.
+func (config *Config) ScreenWidth(value string) (float64, bool) {
+	if arbitrary, ok := strings.CutPrefix(value, "["); ok {
+		return parseCSSLength(strings.TrimSuffix(arbitrary, "]"))
+	}
+
+	width, ok := config.Theme.Breakpoints[value]
+	if !ok {
+		return 0, false
+	}
+
+	return parseCSSLength(width)
+}
+
+// ContainerWidth returns the width in pixels of a container size, such as
+// `md`, or of an arbitrary value, such as `[500px]`.
+func (config *Config) ContainerWidth(value string) (float64, bool) {
+	if arbitrary, ok := strings.CutPrefix(value, "["); ok {
+		return parseCSSLength(strings.TrimSuffix(arbitrary, "]"))
+	}
+
+	width, ok := config.Theme.Containers[value]
+	if !ok {
+		return 0, false
+	}
+
+	return parseCSSLength(width)
+}
+
+func splitCSSDeclaration(node cssNode) (string, string, bool) {
+	if node.Block || strings.HasPrefix(node.Prelude, "@") {
+		return "", "", false
+	}
+
+	name, value, found := strings.Cut(node.Prelude, ":")
+	if !found {
+		return "", "", false
+	}
+
+	return strings.TrimSpace(name), strings.TrimSpace(value), true
+}
+
+func atRuleParams(prelude string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(prelude, "@"), atRuleName(prelude)))
+}
+
+func unquoteCSS(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+func splitCSSList(value string) []string {
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		if item = unquoteCSS(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// parseCSSLength converts a length to pixels, assuming 16px per rem/em.
+func parseCSSLength(value string) (float64, bool) {
+	value = strings.TrimSpace(value)
+
+	for _, unit := range []struct {
+		suffix     string
+		multiplier float64
+	}{{"px", 1}, {"rem", 16}, {"em", 16}} {
+		if number, found := strings.CutSuffix(value, unit.suffix); found {
+			length, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return 0, false
+			}
+			return length * unit.multiplier, true
+		}
+	}
+
+	return 0, false
+}
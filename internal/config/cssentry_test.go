@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestApplyCSSEntryDaisyUIComponents(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     string
+		wantRanked  []string
+		wantIgnored []string
+	}{
+		{
+			name:        "include",
+			options:     "include: button, stat;",
+			wantRanked:  []string{"btn", "btn-primary", "btn-*", "stats", "stat-title", "table-layout-*"},
+			wantIgnored: []string{"card", "card-*", "table", "table-zebra"},
+		},
+		{
+			name:        "exclude",
+			options:     "exclude: button, table;",
+			wantRanked:  []string{"card", "card-*", "stats", "table-layout-*"},
+			wantIgnored: []string{"btn", "btn-primary", "btn-*", "table", "table-zebra", "table-*"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "app.css")
+			source := "@import \"tailwindcss\";\n@plugin \"daisyui\" {\n  " + test.options + "\n}\n"
+			if err := os.WriteFile(path, []byte(source), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			config := defaultConfig()
+			if err := config.applyCSSEntry(path); err != nil {
+				t.Fatalf("applyCSSEntry: %v", err)
+			}
+
+			for _, entry := range test.wantRanked {
+				if !slices.Contains(config.ClassOrder, entry) {
+					t.Errorf("class order is missing %q", entry)
+				}
+			}
+			for _, entry := range test.wantIgnored {
+				if slices.Contains(config.ClassOrder, entry) {
+					t.Errorf("class order still has %q", entry)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,730 @@
+package config
+
+import "slices"
+
+// daisyUIComponent describes a daisyUI component by the kind of its classes.
+// daisyUI documents a canonical order for them: the component, then its
+// parts, styles, colors, sizes and modifiers, e.g.
+// `btn btn-outline btn-primary btn-sm btn-wide`.
+type daisyUIComponent struct {
+	Name      string
+	Parts     []string
+	Styles    []string
+	Colors    []string
+	Sizes     []string
+	Modifiers []string
+	// Families catch the classes of the parts that are not listed, such as
+	// `stat-*` for the `stats` component. The family of the component itself
+	// is added for every component that has members.
+	Families []string
+}
+
+// daisyUIClassOrder flattens the components into class order entries, keeping
+// the order of the components and the canonical order of their classes.
+func daisyUIClassOrder(components []daisyUIComponent) []string {
+	var classOrder []string
+	for _, component := range components {
+		members := slices.Concat(component.Parts, component.Styles, component.Colors, component.Sizes, component.Modifiers)
+
+		classOrder = append(classOrder, component.Name)
+		classOrder = append(classOrder, members...)
+		if len(members) > 0 {
+			classOrder = append(classOrder, component.Name+"-*")
+		}
+		classOrder = append(classOrder, component.Families...)
+	}
+
+	return classOrder
+}
+
+// daisyUIMembers names the members of a component, e.g. `btn-primary` for the
+// `primary` member of `btn`.
+func daisyUIMembers(name string, members ...string) []string {
+	classes := make([]string, 0, len(members))
+	for _, member := range members {
+		classes = append(classes, name+"-"+member)
+	}
+
+	return classes
+}
+
+// daisyUIComponentClasses maps the daisyUI component names used by the
+// `include` and `exclude` plugin options to their classes, when they differ.
+// Other components are named after their class, e.g. `card`.
+var daisyUIComponentClasses map[string][]string = map[string][]string{
+	"button":          {"btn"},
+	"calendar":        {"cally", "pika-single", "react-day-picker"},
+	"fileinput":       {"file-input"},
+	"hovergallery":    {"hover-gallery"},
+	"label":           {"label", "floating-label"},
+	"radialprogress":  {"radial-progress"},
+	"stat":            {"stats", "stat"},
+	"tab":             {"tabs", "tab"},
+	"textrotate":      {"text-rotate"},
+	"themecontroller": {"theme-controller"},
+}
+
+var (
+	daisyUIColors       []string = []string{"neutral", "primary", "secondary", "accent", "info", "success", "warning", "error"}
+	daisyUIStatusColors []string = []string{"info", "success", "warning", "error"}
+	daisyUIV5Sizes      []string = []string{"xs", "sm", "md", "lg", "xl"}
+	daisyUIV4Sizes      []string = []string{"lg", "md", "sm", "xs"}
+)
+
+// daisyUIV5Variants lists the variants added by daisyUI 5.
+func daisyUIV5Variants() []string {
+	return []string{"is-drawer-open", "is-drawer-close"}
+}
+
+// daisyUIV5Components lists the daisyUI 5 components in the order of the
+// daisyUI documentation.
+func daisyUIV5Components() []daisyUIComponent {
+	return []daisyUIComponent{
+		// daisyUI Skeleton
+		{Name: "skeleton"},
+
+		// daisyUI Button
+		{
+			Name:      "btn",
+			Styles:    daisyUIMembers("btn", "outline", "dash", "soft", "ghost", "link"),
+			Colors:    daisyUIMembers("btn", daisyUIColors...),
+			Sizes:     daisyUIMembers("btn", daisyUIV5Sizes...),
+			Modifiers: daisyUIMembers("btn", "active", "disabled", "wide", "block", "square", "circle"),
+		},
+
+		// daisyUI Dropdown
+		{
+			Name:  "dropdown",
+			Parts: []string{"dropdown-content"},
+			Modifiers: daisyUIMembers("dropdown", "start", "center", "end", "top", "bottom", "left", "right", "hover", "open",
+				"close"),
+		},
+
+		// daisyUI Fab / Speed Dial
+		{Name: "fab", Parts: daisyUIMembers("fab", "close", "main-action"), Modifiers: []string{"fab-flower"}},
+
+		// daisyUI Modal
+		{
+			Name:      "modal",
+			Parts:     daisyUIMembers("modal", "box", "action", "backdrop", "toggle"),
+			Modifiers: daisyUIMembers("modal", "open", "top", "middle", "bottom", "start", "end"),
+		},
+
+		// daisyUI Swap
+		{
+			Name:      "swap",
+			Parts:     daisyUIMembers("swap", "on", "off", "indeterminate"),
+			Styles:    daisyUIMembers("swap", "rotate", "flip"),
+			Modifiers: []string{"swap-active"},
+		},
+
+		// daisyUI Accordion / Collapse
+		{
+			Name:      "collapse",
+			Parts:     daisyUIMembers("collapse", "title", "content"),
+			Modifiers: daisyUIMembers("collapse", "arrow", "plus", "open", "close"),
+		},
+
+		// daisyUI Avatar
+		{Name: "avatar", Parts: []string{"avatar-group"}, Modifiers: daisyUIMembers("avatar", "online", "offline", "placeholder")},
+
+		// daisyUI Badge
+		{
+			Name:   "badge",
+			Styles: daisyUIMembers("badge", "outline", "dash", "soft", "ghost"),
+			Colors: daisyUIMembers("badge", daisyUIColors...),
+			Sizes:  daisyUIMembers("badge", daisyUIV5Sizes...),
+		},
+
+		// daisyUI Card
+		{
+			Name:      "card",
+			Parts:     daisyUIMembers("card", "title", "body", "actions"),
+			Styles:    daisyUIMembers("card", "border", "dash"),
+			Sizes:     daisyUIMembers("card", daisyUIV5Sizes...),
+			Modifiers: []string{"card-side", "image-full"},
+		},
+
+		// daisyUI Carousel
+		{
+			Name:      "carousel",
+			Parts:     []string{"carousel-item"},
+			Modifiers: daisyUIMembers("carousel", "start", "center", "end", "horizontal", "vertical"),
+		},
+
+		// daisyUI Chat Bubble
+		{
+			Name:      "chat",
+			Parts:     daisyUIMembers("chat", "image", "header", "footer", "bubble"),
+			Colors:    daisyUIMembers("chat-bubble", daisyUIColors...),
+			Modifiers: daisyUIMembers("chat", "start", "end"),
+			Families:  []string{"chat-bubble-*"},
+		},
+
+		// daisyUI Countdown
+		{Name: "countdown"},
+
+		// daisyUI Diff
+		{Name: "diff", Parts: daisyUIMembers("diff", "item-1", "item-2", "resizer")},
+
+		// daisyUI Hover Gallery
+		{Name: "hover-gallery"},
+
+		// daisyUI KBD
+		{Name: "kbd", Sizes: daisyUIMembers("kbd", daisyUIV5Sizes...)},
+
+		// daisyUI List
+		{Name: "list", Parts: []string{"list-row"}, Modifiers: daisyUIMembers("list", "col-wrap", "col-grow")},
+
+		// daisyUI Stat
+		{
+			Name:      "stats",
+			Parts:     []string{"stat", "stat-title", "stat-value", "stat-desc", "stat-figure", "stat-actions"},
+			Modifiers: daisyUIMembers("stats", "horizontal", "vertical"),
+			Families:  []string{"stat-*"},
+		},
+
+		// daisyUI Status
+		{
+			Name:   "status",
+			Colors: daisyUIMembers("status", daisyUIColors...),
+			Sizes:  daisyUIMembers("status", daisyUIV5Sizes...),
+		},
+
+		// daisyUI Table
+		{
+			Name:      "table",
+			Sizes:     daisyUIMembers("table", daisyUIV5Sizes...),
+			Modifiers: daisyUIMembers("table", "zebra", "pin-rows", "pin-cols"),
+		},
+
+		// daisyUI Timeline
+		{
+			Name:      "timeline",
+			Parts:     daisyUIMembers("timeline", "start", "middle", "end"),
+			Modifiers: daisyUIMembers("timeline", "snap-icon", "box", "compact", "horizontal", "vertical"),
+		},
+
+		// daisyUI Text Rotate
+		{Name: "text-rotate"},
+
+		// daisyUI Breadcrumbs
+		{Name: "breadcrumbs"},
+
+		// daisyUI Dock
+		{
+			Name:      "dock",
+			Parts:     []string{"dock-label"},
+			Sizes:     daisyUIMembers("dock", daisyUIV5Sizes...),
+			Modifiers: []string{"dock-active"},
+		},
+
+		// daisyUI Link
+		{Name: "link", Styles: []string{"link-hover"}, Colors: daisyUIMembers("link", daisyUIColors...)},
+
+		// daisyUI Menu
+		{
+			Name:  "menu",
+			Parts: daisyUIMembers("menu", "title", "dropdown", "dropdown-toggle"),
+			Sizes: daisyUIMembers("menu", daisyUIV5Sizes...),
+			Modifiers: daisyUIMembers("menu", "disabled", "active", "focus", "dropdown-show", "horizontal",
+				"vertical"),
+			Families: []string{"menu-dropdown-*"},
+		},
+
+		// daisyUI Navbar
+		{Name: "navbar", Parts: daisyUIMembers("navbar", "start", "center", "end")},
+
+		// daisyUI Pagination / Join
+		{Name: "join", Parts: []string{"join-item"}, Modifiers: daisyUIMembers("join", "horizontal", "vertical")},
+
+		// daisyUI Steps
+		{
+			Name:      "steps",
+			Parts:     []string{"step", "step-icon"},
+			Colors:    daisyUIMembers("step", daisyUIColors...),
+			Modifiers: daisyUIMembers("steps", "horizontal", "vertical"),
+			Families:  []string{"step-*"},
+		},
+
+		// daisyUI Tabs
+		{
+			Name:      "tabs",
+			Parts:     []string{"tab", "tab-content"},
+			Styles:    daisyUIMembers("tabs", "box", "border", "lift"),
+			Sizes:     daisyUIMembers("tabs", daisyUIV5Sizes...),
+			Modifiers: []string{"tab-active", "tab-disabled", "tabs-top", "tabs-bottom"},
+			Families:  []string{"tab-*"},
+		},
+
+		// daisyUI Alert
+		{
+			Name:      "alert",
+			Styles:    daisyUIMembers("alert", "outline", "dash", "soft"),
+			Colors:    daisyUIMembers("alert", daisyUIStatusColors...),
+			Modifiers: daisyUIMembers("alert", "horizontal", "vertical"),
+		},
+
+		// daisyUI Loading
+		{
+			Name:   "loading",
+			Styles: daisyUIMembers("loading", "spinner", "dots", "ring", "ball", "bars", "infinity"),
+			Sizes:  daisyUIMembers("loading", daisyUIV5Sizes...),
+		},
+
+		// daisyUI Progress
+		{Name: "progress", Colors: daisyUIMembers("progress", daisyUIColors...)},
+
+		// daisyUI Radial Progress
+		{Name: "radial-progress"},
+
+		// daisyUI Toast
+		{Name: "toast", Modifiers: daisyUIMembers("toast", "start", "center", "end", "top", "middle", "bottom")},
+
+		// daisyUI Tooltip
+		{
+			Name:      "tooltip",
+			Parts:     []string{"tooltip-content"},
+			Colors:    daisyUIMembers("tooltip", daisyUIColors...),
+			Modifiers: daisyUIMembers("tooltip", "top", "bottom", "left", "right", "open"),
+		},
+
+		// daisyUI Calendar
+		{Name: "cally"},
+		{Name: "pika-single"},
+		{Name: "react-day-picker"},
+
+		// daisyUI Checkbox
+		{
+			Name:   "checkbox",
+			Colors: daisyUIMembers("checkbox", daisyUIColors...),
+			Sizes:  daisyUIMembers("checkbox", daisyUIV5Sizes...),
+		},
+
+		// daisyUI Fieldset
+		{Name: "fieldset", Parts: []string{"fieldset-legend"}},
+
+		// daisyUI File Input
+		{
+			Name:   "file-input",
+			Styles: []string{"file-input-ghost"},
+			Colors: daisyUIMembers("file-input", daisyUIColors...),
+			Sizes:  daisyUIMembers("file-input", daisyUIV5Sizes...),
+		},
+
+		// daisyUI Field Filter
+		{Name: "filter", Parts: []string{"filter-reset"}},
+
+		// daisyUI Label
+		{Name: "label"},
+		{Name: "floating-label"},
+
+		// daisyUI Radio
+		{Name: "radio", Colors: daisyUIMembers("radio", daisyUIColors...), Sizes: daisyUIMembers("radio", daisyUIV5Sizes...)},
+
+		// daisyUI Range Slider
+		{Name: "range", Colors: daisyUIMembers("range", daisyUIColors...), Sizes: daisyUIMembers("range", daisyUIV5Sizes...)},
+
+		// daisyUI Rating
+		{
+			Name:      "rating",
+			Sizes:     daisyUIMembers("rating", daisyUIV5Sizes...),
+			Modifiers: daisyUIMembers("rating", "half", "hidden"),
+		},
+
+		// daisyUI Select
+		{
+			Name:   "select",
+			Styles: []string{"select-ghost"},
+			Colors: daisyUIMembers("select", daisyUIColors...),
+			Sizes:  daisyUIMembers("select", daisyUIV5Sizes...),
+		},
+
+		// daisyUI Text Input
+		{
+			Name:   "input",
+			Styles: []string{"input-ghost"},
+			Colors: daisyUIMembers("input", daisyUIColors...),
+			Sizes:  daisyUIMembers("input", daisyUIV5Sizes...),
+		},
+
+		// daisyUI Textarea
+		{
+			Name:   "textarea",
+			Styles: []string{"textarea-ghost"},
+			Colors: daisyUIMembers("textarea", daisyUIColors...),
+			Sizes:  daisyUIMembers("textarea", daisyUIV5Sizes...),
+		},
+
+		// daisyUI Toggle
+		{Name: "toggle", Colors: daisyUIMembers("toggle", daisyUIColors...), Sizes: daisyUIMembers("toggle", daisyUIV5Sizes...)},
+
+		// daisyUI Validator
+		{Name: "validator", Parts: []string{"validator-hint"}},
+
+		// daisyUI Divider
+		{
+			Name:      "divider",
+			Colors:    daisyUIMembers("divider", daisyUIColors...),
+			Modifiers: daisyUIMembers("divider", "start", "end", "horizontal", "vertical"),
+		},
+
+		// daisyUI Drawer
+		{
+			Name:      "drawer",
+			Parts:     daisyUIMembers("drawer", "toggle", "content", "side", "overlay"),
+			Modifiers: daisyUIMembers("drawer", "end", "open"),
+		},
+
+		// daisyUI Footer
+		{
+			Name:      "footer",
+			Parts:     []string{"footer-title"},
+			Modifiers: daisyUIMembers("footer", "center", "horizontal", "vertical"),
+		},
+
+		// daisyUI Hero
+		{Name: "hero", Parts: daisyUIMembers("hero", "content", "overlay")},
+
+		// daisyUI Indicator
+		{
+			Name:      "indicator",
+			Parts:     []string{"indicator-item"},
+			Modifiers: daisyUIMembers("indicator", "start", "center", "end", "top", "middle", "bottom"),
+		},
+
+		// daisyUI Mask
+		{
+			Name: "mask",
+			Styles: daisyUIMembers("mask", "squircle", "heart", "hexagon", "hexagon-2", "decagon", "pentagon", "diamond",
+				"square", "circle", "star", "star-2", "triangle", "triangle-2", "triangle-3", "triangle-4"),
+			Modifiers: daisyUIMembers("mask", "half-1", "half-2"),
+		},
+
+		// daisyUI Stack
+		{Name: "stack", Modifiers: daisyUIMembers("stack", "top", "bottom", "start", "end")},
+
+		// daisyUI Browser
+		{Name: "mockup-browser", Parts: []string{"mockup-browser-toolbar"}},
+
+		// daisyUI Code
+		{Name: "mockup-code"},
+
+		// daisyUI Phone
+		{Name: "mockup-phone", Parts: daisyUIMembers("mockup-phone", "camera", "display")},
+
+		// daisyUI Window
+		{Name: "mockup-window"},
+
+		// daisyUI Glass
+		{Name: "glass"},
+
+		// daisyUI Theme Controller
+		{Name: "theme-controller"},
+	}
+}
+
+// daisyUIV4Components lists the daisyUI 4 components in the order of the
+// daisyUI documentation.
+func daisyUIV4Components() []daisyUIComponent {
+	return []daisyUIComponent{
+		// daisyUI Button
+		{
+			Name:      "btn",
+			Styles:    daisyUIMembers("btn", "ghost", "link", "outline", "glass"),
+			Colors:    daisyUIMembers("btn", daisyUIColors...),
+			Sizes:     daisyUIMembers("btn", daisyUIV4Sizes...),
+			Modifiers: []string{"btn-active", "btn-disabled", "no-animation", "btn-wide", "btn-block", "btn-circle", "btn-square"},
+		},
+
+		// daisyUI Dropdown
+		{
+			Name:      "dropdown",
+			Parts:     []string{"dropdown-content"},
+			Modifiers: daisyUIMembers("dropdown", "end", "top", "bottom", "left", "right", "hover", "open"),
+		},
+
+		// daisyUI Modal
+		{
+			Name:      "modal",
+			Parts:     daisyUIMembers("modal", "box", "action", "backdrop", "toggle"),
+			Modifiers: daisyUIMembers("modal", "open", "top", "bottom", "middle"),
+		},
+
+		// daisyUI Swap
+		{
+			Name:      "swap",
+			Parts:     daisyUIMembers("swap", "on", "off", "indeterminate"),
+			Styles:    daisyUIMembers("swap", "rotate", "flip"),
+			Modifiers: []string{"swap-active"},
+		},
+
+		// daisyUI Theme Controller
+		{Name: "theme-controller"},
+
+		// daisyUI Accordion / Collapse
+		{
+			Name:      "collapse",
+			Parts:     daisyUIMembers("collapse", "title", "content"),
+			Modifiers: daisyUIMembers("collapse", "arrow", "plus", "open", "close"),
+		},
+
+		// daisyUI Avatar
+		{Name: "avatar", Parts: []string{"avatar-group"}, Modifiers: []string{"online", "offline", "placeholder"}},
+
+		// daisyUI Badge
+		{
+			Name:   "badge",
+			Styles: daisyUIMembers("badge", "ghost", "outline"),
+			Colors: daisyUIMembers("badge", daisyUIColors...),
+			Sizes:  daisyUIMembers("badge", daisyUIV4Sizes...),
+		},
+
+		// daisyUI Card
+		{
+			Name:      "card",
+			Parts:     daisyUIMembers("card", "title", "body", "actions"),
+			Styles:    []string{"card-bordered"},
+			Sizes:     daisyUIMembers("card", "normal", "compact"),
+			Modifiers: []string{"image-full", "card-side"},
+		},
+
+		// daisyUI Carousel
+		{
+			Name:      "carousel",
+			Parts:     []string{"carousel-item"},
+			Modifiers: daisyUIMembers("carousel", "start", "center", "end", "vertical"),
+		},
+
+		// daisyUI Chat Bubble
+		{
+			Name:      "chat",
+			Parts:     daisyUIMembers("chat", "image", "header", "footer", "bubble"),
+			Colors:    daisyUIMembers("chat-bubble", daisyUIColors...),
+			Modifiers: daisyUIMembers("chat", "start", "end"),
+			Families:  []string{"chat-bubble-*"},
+		},
+
+		// daisyUI Countdown
+		{Name: "countdown"},
+
+		// daisyUI Diff
+		{Name: "diff", Parts: daisyUIMembers("diff", "item-1", "item-2", "resizer")},
+
+		// daisyUI KBD
+		{Name: "kbd", Sizes: daisyUIMembers("kbd", daisyUIV4Sizes...)},
+
+		// daisyUI Stat
+		{
+			Name:      "stats",
+			Parts:     []string{"stat", "stat-title", "stat-value", "stat-desc", "stat-figure", "stat-actions"},
+			Modifiers: daisyUIMembers("stats", "horizontal", "vertical"),
+			Families:  []string{"stat-*"},
+		},
+
+		// daisyUI Table
+		{
+			Name:      "table",
+			Sizes:     daisyUIMembers("table", daisyUIV4Sizes...),
+			Modifiers: daisyUIMembers("table", "zebra", "pin-rows", "pin-cols"),
+		},
+
+		// daisyUI Timeline
+		{
+			Name:      "timeline",
+			Parts:     daisyUIMembers("timeline", "start", "middle", "end", "box"),
+			Modifiers: daisyUIMembers("timeline", "snap-icon", "compact", "vertical", "horizontal"),
+		},
+
+		// daisyUI Breadcrumbs
+		{Name: "breadcrumbs"},
+
+		// daisyUI Bottom Navigation
+		{Name: "btm-nav", Sizes: daisyUIMembers("btm-nav", daisyUIV4Sizes...)},
+
+		// daisyUI Link
+		{Name: "link", Styles: []string{"link-hover"}, Colors: daisyUIMembers("link", daisyUIColors...)},
+
+		// daisyUI Menu
+		{
+			Name:      "menu",
+			Parts:     daisyUIMembers("menu", "title", "dropdown", "dropdown-toggle"),
+			Sizes:     daisyUIMembers("menu", daisyUIV4Sizes...),
+			Modifiers: daisyUIMembers("menu", "dropdown-show", "vertical", "horizontal"),
+		},
+
+		// daisyUI Navbar
+		{Name: "navbar", Parts: daisyUIMembers("navbar", "start", "center", "end")},
+
+		// daisyUI Pagination / Join
+		{Name: "join", Parts: []string{"join-item"}, Modifiers: daisyUIMembers("join", "vertical", "horizontal")},
+
+		// daisyUI Steps
+		{
+			Name:      "steps",
+			Parts:     []string{"step"},
+			Colors:    daisyUIMembers("step", daisyUIColors...),
+			Modifiers: daisyUIMembers("steps", "vertical", "horizontal"),
+			Families:  []string{"step-*"},
+		},
+
+		// daisyUI Tabs
+		{
+			Name:      "tabs",
+			Parts:     []string{"tab", "tab-content"},
+			Styles:    daisyUIMembers("tabs", "boxed", "bordered", "lifted"),
+			Sizes:     daisyUIMembers("tabs", daisyUIV4Sizes...),
+			Modifiers: []string{"tab-active", "tab-disabled"},
+			Families:  []string{"tab-*"},
+		},
+
+		// daisyUI Alert
+		{Name: "alert", Colors: daisyUIMembers("alert", daisyUIStatusColors...)},
+
+		// daisyUI Loading
+		{
+			Name:   "loading",
+			Styles: daisyUIMembers("loading", "spinner", "dots", "ring", "ball", "bars", "infinity"),
+			Sizes:  daisyUIMembers("loading", daisyUIV4Sizes...),
+		},
+
+		// daisyUI Progress
+		{Name: "progress", Colors: daisyUIMembers("progress", daisyUIColors[1:]...)},
+
+		// daisyUI Radial Progress
+		{Name: "radial-progress"},
+
+		// daisyUI Skeleton
+		{Name: "skeleton"},
+
+		// daisyUI Toast
+		{Name: "toast", Modifiers: daisyUIMembers("toast", "start", "center", "end", "top", "middle", "bottom")},
+
+		// daisyUI Tooltip
+		{
+			Name:      "tooltip",
+			Colors:    daisyUIMembers("tooltip", daisyUIColors[1:]...),
+			Modifiers: daisyUIMembers("tooltip", "open", "top", "bottom", "left", "right"),
+		},
+
+		// daisyUI Checkbox
+		{
+			Name:   "checkbox",
+			Colors: daisyUIMembers("checkbox", daisyUIColors[1:]...),
+			Sizes:  daisyUIMembers("checkbox", daisyUIV4Sizes...),
+		},
+
+		// daisyUI File Input
+		{
+			Name:   "file-input",
+			Styles: daisyUIMembers("file-input", "bordered", "ghost"),
+			Colors: daisyUIMembers("file-input", daisyUIColors[1:]...),
+			Sizes:  daisyUIMembers("file-input", daisyUIV4Sizes...),
+		},
+
+		// daisyUI Radio
+		{Name: "radio", Colors: daisyUIMembers("radio", daisyUIColors[1:]...), Sizes: daisyUIMembers("radio", daisyUIV4Sizes...)},
+
+		// daisyUI Range Slider
+		{Name: "range", Colors: daisyUIMembers("range", daisyUIColors[1:]...), Sizes: daisyUIMembers("range", daisyUIV4Sizes...)},
+
+		// daisyUI Rating
+		{
+			Name:      "rating",
+			Sizes:     daisyUIMembers("rating", daisyUIV4Sizes...),
+			Modifiers: daisyUIMembers("rating", "half", "hidden"),
+		},
+
+		// daisyUI Select
+		{
+			Name:   "select",
+			Styles: daisyUIMembers("select", "bordered", "ghost"),
+			Colors: daisyUIMembers("select", daisyUIColors[1:]...),
+			Sizes:  daisyUIMembers("select", daisyUIV4Sizes...),
+		},
+
+		// daisyUI Text Input
+		{
+			Name:   "input",
+			Styles: daisyUIMembers("input", "bordered", "ghost"),
+			Colors: daisyUIMembers("input", daisyUIColors[1:]...),
+			Sizes:  daisyUIMembers("input", daisyUIV4Sizes...),
+		},
+
+		// daisyUI Textarea
+		{
+			Name:   "textarea",
+			Styles: daisyUIMembers("textarea", "bordered", "ghost"),
+			Colors: daisyUIMembers("textarea", daisyUIColors[1:]...),
+			Sizes:  daisyUIMembers("textarea", daisyUIV4Sizes...),
+		},
+
+		// daisyUI Toggle
+		{Name: "toggle", Colors: daisyUIMembers("toggle", daisyUIColors[1:]...), Sizes: daisyUIMembers("toggle", daisyUIV4Sizes...)},
+
+		// daisyUI Form Control
+		{Name: "form-control", Parts: []string{"label", "label-text", "label-text-alt"}, Families: []string{"label-*"}},
+
+		// daisyUI Artboard
+		{
+			Name:      "artboard",
+			Sizes:     daisyUIMembers("phone", "1", "2", "3", "4", "5", "6"),
+			Modifiers: daisyUIMembers("artboard", "demo", "horizontal"),
+			Families:  []string{"phone-*"},
+		},
+
+		// daisyUI Divider
+		{
+			Name:      "divider",
+			Colors:    daisyUIMembers("divider", daisyUIColors...),
+			Modifiers: daisyUIMembers("divider", "vertical", "horizontal", "start", "end"),
+		},
+
+		// daisyUI Drawer
+		{
+			Name:      "drawer",
+			Parts:     daisyUIMembers("drawer", "toggle", "content", "side", "overlay"),
+			Modifiers: daisyUIMembers("drawer", "end", "open"),
+		},
+
+		// daisyUI Footer
+		{Name: "footer", Parts: []string{"footer-title"}, Modifiers: []string{"footer-center"}},
+
+		// daisyUI Hero
+		{Name: "hero", Parts: daisyUIMembers("hero", "content", "overlay")},
+
+		// daisyUI Indicator
+		{
+			Name:      "indicator",
+			Parts:     []string{"indicator-item"},
+			Modifiers: daisyUIMembers("indicator", "start", "center", "end", "top", "middle", "bottom"),
+		},
+
+		// daisyUI Mask
+		{
+			Name: "mask",
+			Styles: daisyUIMembers("mask", "squircle", "heart", "hexagon", "hexagon-2", "decagon", "pentagon", "diamond",
+				"square", "circle", "parallelogram", "parallelogram-2", "parallelogram-3", "parallelogram-4", "star",
+				"star-2", "triangle", "triangle-2", "triangle-3", "triangle-4"),
+			Modifiers: daisyUIMembers("mask", "half-1", "half-2"),
+		},
+
+		// daisyUI Stack
+		{Name: "stack"},
+
+		// daisyUI Browser
+		{Name: "mockup-browser", Parts: []string{"mockup-browser-toolbar"}},
+
+		// daisyUI Code
+		{Name: "mockup-code"},
+
+		// daisyUI Phone
+		{Name: "mockup-phone", Parts: daisyUIMembers("mockup-phone", "camera", "display")},
+
+		// daisyUI Window
+		{Name: "mockup-window"},
+
+		// daisyUI Glass
+		{Name: "glass"},
+	}
+}
@@ -0,0 +1,546 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	tailwindConfigFileNames []string = []string{"tailwind.config.js", "tailwind.config.cjs", "tailwind.config.mjs", "tailwind.config.ts"}
+
+	jsImportRegex  *regexp.Regexp = regexp.MustCompile(`import\s+([\w$]+)\s+from\s+['"]([^'"]+)['"]`)
+	jsRequireRegex *regexp.Regexp = regexp.MustCompile(`(?:const|let|var)\s+([\w$]+)\s*=\s*require\(\s*['"]([^'"]+)['"]\s*\)`)
+	jsExportRegex  *regexp.Regexp = regexp.MustCompile(`(?:module\.exports\s*=|export\s+default)\s*`)
+	jsPluginRegex  *regexp.Regexp = regexp.MustCompile(`^require\(\s*['"]([^'"]+)['"]\s*\)|^([\w$]+)`)
+)
+
+// jsObject is a JavaScript object literal. Its values are strings, float64,
+// bool, nil, *jsObject, []any or jsExpression.
+type jsObject struct {
+	Keys   []string
+	Values map[string]any
+}
+
+// jsExpression is code that cannot be evaluated without running JavaScript.
+type jsExpression string
+
+func (object *jsObject) get(keyPath ...string) (any, bool) {
+	var value any = object
+	for _, key := range keyPath {
+		current, ok := value.(*jsObject)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = current.Values[key]; !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// applyTailwindConfig reads the static parts of a Tailwind CSS v3 JavaScript
+// or TypeScript config file. Parts that need JavaScript to be evaluated are
+// skipped with a warning, and so is the whole file when its export is not an
+// object literal. Only a file that cannot be read is an error.
+func (config *Config) applyTailwindConfig(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	source := string(content)
+	root, err := parseTailwindConfigObject(source)
+	if err != nil {
+		config.Warnings = append(config.Warnings, fmt.Sprintf("%s: %v, the file was ignored", filepath.Base(path), err))
+		return nil
+	}
+
+	warn := func(keyPath string) {
+		config.Warnings = append(config.Warnings, fmt.Sprintf("%s: %s is not a static value and was ignored", filepath.Base(path), keyPath))
+	}
+
+	// Spread objects, such as a shared base config, cannot be read.
+	for _, keyPath := range [][]string{nil, {"theme"}, {"theme", "extend"}} {
+		value, ok := root.get(keyPath...)
+		if !ok {
+			continue
+		}
+		object, ok := value.(*jsObject)
+		if !ok {
+			warn(strings.Join(keyPath, "."))
+			continue
+		}
+		spread, ok := object.Values["..."].(jsExpression)
+		if !ok {
+			continue
+		}
+		if len(keyPath) > 0 {
+			warn(fmt.Sprintf("%s { ...%s }", strings.Join(keyPath, "."), spread))
+		} else {
+			warn("..." + string(spread))
+		}
+	}
+
+	for key, target := range map[string]*string{"prefix": &config.Prefix, "separator": &config.Separator} {
+		switch value := root.Values[key].(type) {
+		case nil:
+		case string:
+			*target = value
+		default:
+			warn(key)
+		}
+	}
+
+	if config.Separator == "" {
+		config.Separator = ":"
+	}
+
+	for _, section := range []struct {
+		name   string
+		values map[string]string
+	}{
+		{"screens", config.Theme.Breakpoints},
+		// The sizes of the @tailwindcss/container-queries plugin.
+		{"containers", config.Theme.Containers},
+		{"colors", config.Theme.Colors},
+	} {
+		if value, ok := root.get("theme", section.name); ok {
+			// A computed section does not replace the defaults.
+			if _, ok := value.(*jsObject); ok {
+				clear(section.values)
+			}
+			flattenThemeValues(value, "", section.values, "theme."+section.name, warn)
+		}
+		if value, ok := root.get("theme", "extend", section.name); ok {
+			flattenThemeValues(value, "", section.values, "theme.extend."+section.name, warn)
+		}
+	}
+
+	if value, ok := root.get("plugins"); ok {
+		config.Plugins = append(config.Plugins, readPluginNames(source, value, warn)...)
+	}
+
+	if prefix, ok := root.get("daisyui", "prefix"); ok {
+		if prefix, ok := prefix.(string); ok {
+			config.DaisyUI.Prefix = prefix
+		} else {
+			warn("daisyui.prefix")
+		}
+	}
+
+	config.rankBreakpoints()
+
+	return nil
+}
+
+// flattenThemeValues turns nested theme objects into Tailwind's dashed keys,
+// so `{ brand: { DEFAULT: ..., 500: ... } }` gives `brand` and `brand-500`.
+func flattenThemeValues(value any, prefix string, values map[string]string, keyPath string, warn func(string)) {
+	switch value := value.(type) {
+	case *jsObject:
+		for _, key := range value.Keys {
+			if key == "..." {
+				warn(keyPath + " (spread)")
+				continue
+			}
+
+			name := key
+			switch {
+			case key == "DEFAULT":
+				name = prefix
+			case prefix != "":
+				name = prefix + "-" + key
+			}
+
+			// Screens may be written as `{ min: '640px' }` or `{ max: '767px' }`.
+			if screen, ok := value.Values[key].(*jsObject); ok && strings.HasSuffix(keyPath, "screens") {
+				if width, ok := screen.Values["min"].(string); ok {
+					values[name] = width
+				} else if width, ok := screen.Values["max"].(string); ok {
+					values[name] = width
+				} else {
+					values[name] = ""
+				}
+				continue
+			}
+
+			flattenThemeValues(value.Values[key], name, values, keyPath+"."+key, warn)
+		}
+	case string:
+		values[prefix] = value
+	case float64:
+		values[prefix] = strconv.FormatFloat(value, 'f', -1, 64)
+	case jsExpression:
+		// The key is still usable even when the value is computed.
+		if prefix == "" {
+			warn(keyPath)
+			return
+		}
+		values[prefix] = ""
+	default:
+		warn(keyPath)
+	}
+}
+
+// readPluginNames names the plugins of the `plugins` list, following the
+// imports of the file, e.g. `require("daisyui")` or `typography` imported
+// from `@tailwindcss/typography`.
+func readPluginNames(source string, value any, warn func(string)) []string {
+	plugins, ok := value.([]any)
+	if !ok {
+		warn("plugins")
+		return nil
+	}
+
+	imports := make(map[string]string)
+	for _, match := range jsImportRegex.FindAllStringSubmatch(source, -1) {
+		imports[match[1]] = match[2]
+	}
+	for _, match := range jsRequireRegex.FindAllStringSubmatch(source, -1) {
+		imports[match[1]] = match[2]
+	}
+
+	var names []string
+	for idx, plugin := range plugins {
+		expression, ok := plugin.(jsExpression)
+		if !ok {
+			warn(fmt.Sprintf("plugins[%d]", idx))
+			continue
+		}
+
+		match := jsPluginRegex.FindStringSubmatch(string(expression))
+		switch {
+		case match == nil:
+			warn(fmt.Sprintf("plugins[%d]", idx))
+		case match[1] != "":
+			names = append(names, match[1])
+		case imports[match[2]] != "":
+			names = append(names, imports[match[2]])
+		default:
+			names = append(names, match[2])
+		}
+	}
+
+	return names
+}
+
+// parseTailwindConfigObject finds the exported config object, following
+// `export default config`, `module.exports = defineConfig({...})` and
+// `satisfies Config` forms.
+func parseTailwindConfigObject(source string) (*jsObject, error) {
+	location := jsExportRegex.FindStringIndex(source)
+	if location == nil {
+		return nil, errors.New("no exported config object found")
+	}
+
+	parser := &jsParser{source: source, pos: location[1]}
+	for range 2 {
+		parser.skipSpace()
+		if parser.peek() == '{' {
+			break
+		}
+
+		identifier := parser.readIdentifier()
+		if identifier == "" || identifier == "function" || identifier == "class" || identifier == "async" {
+			return nil, errors.New("the exported config is not an object literal")
+		}
+
+		parser.skipSpace()
+		if parser.peek() == '(' {
+			parser.pos++
+			continue
+		}
+
+		declarationRegex := regexp.MustCompile(`(?:const|let|var)\s+` + regexp.QuoteMeta(identifier) + `\s*(?::\s*[\w$.<>]+\s*)?=\s*`)
+		declaration := declarationRegex.FindStringIndex(source)
+		if declaration == nil {
+			return nil, fmt.Errorf("cannot find the declaration of %s", identifier)
+		}
+		parser.pos = declaration[1]
+	}
+
+	parser.skipSpace()
+	if parser.peek() != '{' {
+		return nil, errors.New("the exported config is not an object literal")
+	}
+
+	return parser.parseObject()
+}
+
+// jsParser reads the subset of JavaScript used by config files: object and
+// array literals, strings, numbers and booleans. Anything else is kept as an
+// opaque jsExpression.
+type jsParser struct {
+	source string
+	pos    int
+}
+
+func (parser *jsParser) peek() byte {
+	if parser.pos >= len(parser.source) {
+		return 0
+	}
+
+	return parser.source[parser.pos]
+}
+
+func (parser *jsParser) skipSpace() {
+	for parser.pos < len(parser.source) {
+		switch {
+		case strings.ContainsRune(" \t\r\n", rune(parser.source[parser.pos])):
+			parser.pos++
+		case strings.HasPrefix(parser.source[parser.pos:], "//"):
+			end := strings.IndexByte(parser.source[parser.pos:], '\n')
+			if end == -1 {
+				parser.pos = len(parser.source)
+				return
+			}
+			parser.pos += end + 1
+		case strings.HasPrefix(parser.source[parser.pos:], "/*"):
+			end := strings.Index(parser.source[parser.pos+2:], "*/")
+			if end == -1 {
+				parser.pos = len(parser.source)
+				return
+			}
+			parser.pos += end + 4
+		default:
+			return
+		}
+	}
+}
+
+func (parser *jsParser) readIdentifier() string {
+	start := parser.pos
+	for parser.pos < len(parser.source) {
+		char := parser.source[parser.pos]
+		if char != '$' && char != '_' && !(char >= 'a' && char <= 'z') && !(char >= 'A' && char <= 'Z') && !(char >= '0' && char <= '9') {
+			break
+		}
+		parser.pos++
+	}
+
+	return parser.source[start:parser.pos]
+}
+
+func (parser *jsParser) parseValue() (any, error) {
+	parser.skipSpace()
+	start := parser.pos
+
+	var value any
+	var err error
+
+	switch char := parser.peek(); {
+	case char == '{':
+		value, err = parser.parseObject()
+	case char == '[':
+		value, err = parser.parseArray()
+	case char == '"' || char == '\'' || char == '`':
+		value, err = parser.parseString()
+	case char == '-' || char == '.' || (char >= '0' && char <= '9'):
+		value, err = parser.parseNumber()
+	default:
+		switch parser.readIdentifier() {
+		case "true":
+			value = true
+		case "false":
+			value = false
+		case "null", "undefined":
+			value = nil
+		default:
+			err = errors.New("not a literal")
+		}
+	}
+
+	// Anything followed by an operator, a call or a member access is an
+	// expression rather than a literal.
+	parser.skipSpace()
+	if next := parser.peek(); err != nil || (next != ',' && next != '}' && next != ']' && next != ')' && next != ';' && next != 0) {
+		parser.pos = start
+		return parser.parseExpression()
+	}
+
+	return value, nil
+}
+
+func (parser *jsParser) parseObject() (*jsObject, error) {
+	object := &jsObject{Values: make(map[string]any)}
+	parser.pos++
+
+	for {
+		parser.skipSpace()
+		switch parser.peek() {
+		case 0:
+			return nil, errors.New("unterminated object literal")
+		case '}':
+			parser.pos++
+			return object, nil
+		case ',':
+			parser.pos++
+			continue
+		}
+
+		var key string
+		switch char := parser.peek(); {
+		case strings.HasPrefix(parser.source[parser.pos:], "..."):
+			parser.pos += 3
+			expression, err := parser.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			object.Keys = append(object.Keys, "...")
+			object.Values["..."] = expression
+			continue
+		case char == '"' || char == '\'':
+			value, err := parser.parseString()
+			if err != nil {
+				return nil, err
+			}
+			key = value.(string)
+		case char == '[':
+			computedKey, err := parser.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			key = string(computedKey)
+		default:
+			key = parser.readIdentifier()
+			if key == "" {
+				return nil, fmt.Errorf("unexpected %q in object literal", char)
+			}
+		}
+
+		parser.skipSpace()
+		switch parser.peek() {
+		case ':':
+			parser.pos++
+			value, err := parser.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			object.Values[key] = value
+		case '(':
+			// A method such as `plugin() {}` is kept as an expression.
+			start := parser.pos
+			if _, err := parser.parseExpression(); err != nil {
+				return nil, err
+			}
+			object.Values[key] = jsExpression(parser.source[start:parser.pos])
+		default:
+			// Shorthand property, e.g. `{ colors }`.
+			object.Values[key] = jsExpression(key)
+		}
+
+		if _, exists := object.Values[key]; exists {
+			object.Keys = append(object.Keys, key)
+		}
+	}
+}
+
+func (parser *jsParser) parseArray() ([]any, error) {
+	array := []any{}
+	parser.pos++
+
+	for {
+		parser.skipSpace()
+		switch parser.peek() {
+		case 0:
+			return nil, errors.New("unterminated array literal")
+		case ']':
+			parser.pos++
+			return array, nil
+		case ',':
+			parser.pos++
+			continue
+		}
+
+		value, err := parser.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, value)
+	}
+}
+
+func (parser *jsParser) parseString() (any, error) {
+	start := parser.pos
+	quote := parser.source[parser.pos]
+	var value strings.Builder
+
+	for idx := parser.pos + 1; idx < len(parser.source); idx++ {
+		switch char := parser.source[idx]; {
+		case char == '\\' && idx+1 < len(parser.source):
+			value.WriteByte(parser.source[idx+1])
+			idx++
+		case char == quote:
+			parser.pos = idx + 1
+			if quote == '`' && strings.Contains(value.String(), "${") {
+				return jsExpression(parser.source[start:parser.pos]), nil
+			}
+			return value.String(), nil
+		default:
+			value.WriteByte(char)
+		}
+	}
+
+	return nil, errors.New("unterminated string literal")
+}
+
+func (parser *jsParser) parseNumber() (any, error) {
+	start := parser.pos
+	for parser.pos < len(parser.source) && strings.ContainsRune("-+.0123456789eE_xXabcdefABCDEF", rune(parser.source[parser.pos])) {
+		parser.pos++
+	}
+
+	number, err := strconv.ParseFloat(strings.ReplaceAll(parser.source[start:parser.pos], "_", ""), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", parser.source[start:parser.pos])
+	}
+
+	return number, nil
+}
+
+// parseExpression skips to the end of the current expression, which is the
+// first `,` or closing bracket that is not nested in brackets or strings.
+func (parser *jsParser) parseExpression() (jsExpression, error) {
+	parser.skipSpace()
+	start := parser.pos
+	depth := 0
+
+	for parser.pos < len(parser.source) {
+		switch char := parser.source[parser.pos]; char {
+		case '"', '\'', '`':
+			if _, err := parser.parseString(); err != nil {
+				return "", err
+			}
+			continue
+		case '/':
+			if strings.HasPrefix(parser.source[parser.pos:], "//") || strings.HasPrefix(parser.source[parser.pos:], "/*") {
+				parser.skipSpace()
+				continue
+			}
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth == 0 {
+				return jsExpression(strings.TrimSpace(parser.source[start:parser.pos])), nil
+			}
+			depth--
+			if depth == 0 && char == ']' && parser.source[start] == '[' {
+				parser.pos++
+				return jsExpression(strings.TrimSpace(parser.source[start:parser.pos])), nil
+			}
+		case ',', ';':
+			if depth == 0 {
+				return jsExpression(strings.TrimSpace(parser.source[start:parser.pos])), nil
+			}
+		}
+		parser.pos++
+	}
+
+	return jsExpression(strings.TrimSpace(parser.source[start:])), nil
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestApplyTailwindConfigNonStaticValues(t *testing.T) {
+	tests := []struct {
+		name         string
+		source       string
+		wantWarnings []string
+		wantColors   map[string]string
+	}{
+		{
+			name:         "shared theme",
+			source:       "module.exports = { theme: sharedTheme };",
+			wantWarnings: []string{"tailwind.config.js: theme is not a static value and was ignored"},
+			wantColors:   map[string]string{},
+		},
+		{
+			name:         "shared extend",
+			source:       "module.exports = { theme: { extend: sharedExtend, colors: { brand: '#123456' } } };",
+			wantWarnings: []string{"tailwind.config.js: theme.extend is not a static value and was ignored"},
+			wantColors:   map[string]string{"brand": "#123456"},
+		},
+		{
+			name:   "spreads",
+			source: "module.exports = { ...base, theme: { ...baseTheme, extend: { ...baseExtend, colors: { brand: '#123456' } } } };",
+			wantWarnings: []string{
+				"tailwind.config.js: ...base is not a static value and was ignored",
+				"tailwind.config.js: theme { ...baseTheme } is not a static value and was ignored",
+				"tailwind.config.js: theme.extend { ...baseExtend } is not a static value and was ignored",
+			},
+			wantColors: map[string]string{"brand": "#123456"},
+		},
+		{
+			name:         "shared screens",
+			source:       "module.exports = { theme: { screens: sharedScreens } };",
+			wantWarnings: []string{"tailwind.config.js: theme.screens is not a static value and was ignored"},
+			wantColors:   map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "tailwind.config.js")
+			if err := os.WriteFile(path, []byte(test.source), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			config := defaultConfig()
+			if err := config.applyTailwindConfig(path); err != nil {
+				t.Fatalf("applyTailwindConfig: %v", err)
+			}
+
+			if !slices.Equal(config.Warnings, test.wantWarnings) {
+				t.Errorf("warnings = %q, want %q", config.Warnings, test.wantWarnings)
+			}
+			for name, want := range test.wantColors {
+				if got := config.Theme.Colors[name]; got != want {
+					t.Errorf("color %s = %q, want %q", name, got, want)
+				}
+			}
+			if len(config.Theme.Breakpoints) != 5 {
+				t.Errorf("breakpoints = %v, want the defaults", config.Theme.Breakpoints)
+			}
+		})
+	}
+}
+
+func TestApplyTailwindConfigPlugins(t *testing.T) {
+	source := `import typography from "@tailwindcss/typography";
+const daisyui = require("daisyui");
+
+export default {
+  plugins: [typography, daisyui, require("@tailwindcss/forms")({ strategy: "class" }), myPlugin],
+};`
+	path := filepath.Join(t.TempDir(), "tailwind.config.js")
+	if err := os.WriteFile(path, []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := defaultConfig()
+	if err := config.applyTailwindConfig(path); err != nil {
+		t.Fatalf("applyTailwindConfig: %v", err)
+	}
+	config.applyPlugins()
+
+	wantPlugins := []string{"@tailwindcss/typography", "daisyui", "@tailwindcss/forms", "myPlugin"}
+	if !slices.Equal(config.Plugins, wantPlugins) {
+		t.Errorf("plugins = %q, want %q", config.Plugins, wantPlugins)
+	}
+	if !config.DaisyUI.Enabled {
+		t.Error("daisyUI is not enabled")
+	}
+	if !slices.Equal(config.ClassOrder[:3], []string{"prose", "prose-*", "not-prose"}) {
+		t.Errorf("class order starts with %q, want the typography classes", config.ClassOrder[:3])
+	}
+	if !slices.Contains(config.ClassOrder, "form-input") {
+		t.Error("class order is missing form-input")
+	}
+	if len(config.Warnings) != 0 {
+		t.Errorf("warnings = %q, want none", config.Warnings)
+	}
+}
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type MatchKind int
+
+const (
+	// MatchExact entries such as `btn` only match that class.
+	MatchExact MatchKind = iota
+	// MatchPrefix entries such as `btn-*` match `btn-primary` but neither
+	// `btn` nor `btnbar`. A trailing `-` (`float-`) is read the same way.
+	MatchPrefix
+	// MatchRegex entries such as `re:^js-` match classes against a regular
+	// expression.
+	MatchRegex
+)
+
+const regexEntryPrefix string = "re:"
+
+type OrderMatcher struct {
+	Kind  MatchKind
+	Entry string
+
+	value string
+	regex *regexp.Regexp
+}
+
+func ParseOrderEntry(entry string) (OrderMatcher, error) {
+	switch {
+	case strings.HasPrefix(entry, regexEntryPrefix):
+		regex, err := regexp.Compile(strings.TrimPrefix(entry, regexEntryPrefix))
+		if err != nil {
+			return OrderMatcher{}, fmt.Errorf("invalid class order entry %q: %w", entry, err)
+		}
+		return OrderMatcher{Kind: MatchRegex, Entry: entry, regex: regex}, nil
+	case strings.HasSuffix(entry, "-*"):
+		return OrderMatcher{Kind: MatchPrefix, Entry: entry, value: strings.TrimSuffix(entry, "*")}, nil
+	case strings.HasSuffix(entry, "-"):
+		return OrderMatcher{Kind: MatchPrefix, Entry: entry, value: entry}, nil
+	case entry == "" || strings.Contains(entry, "*"):
+		return OrderMatcher{}, fmt.Errorf("invalid class order entry %q: use `name`, `name-*` or `re:<pattern>`", entry)
+	}
+
+	return OrderMatcher{Kind: MatchExact, Entry: entry, value: entry}, nil
+}
+
+// Value returns the class name of an exact entry or the prefix of a family
+// entry, including its trailing dash.
+func (matcher OrderMatcher) Value() string {
+	return matcher.value
+}
+
+func (matcher OrderMatcher) Match(className string) bool {
+	switch matcher.Kind {
+	case MatchPrefix:
+		return len(className) > len(matcher.value) && strings.HasPrefix(className, matcher.value)
+	case MatchRegex:
+		return matcher.regex.MatchString(className)
+	}
+
+	return className == matcher.value
+}
+
+// ClassOrderMatchers compiles every ClassOrder entry, keeping their order.
+func (config *Config) ClassOrderMatchers() ([]OrderMatcher, error) {
+	matchers := make([]OrderMatcher, 0, len(config.ClassOrder))
+	for _, entry := range config.ClassOrder {
+		matcher, err := ParseOrderEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, matcher)
+	}
+
+	return matchers, nil
+}
+
+// CustomGroupMatchers compiles the custom groups placed at the start and at
+// the end of the class list.
+func (config *Config) CustomGroupMatchers() ([]OrderMatcher, []OrderMatcher, error) {
+	var start, end []OrderMatcher
+	for _, group := range config.CustomGroups {
+		matcher, err := ParseOrderEntry(regexEntryPrefix + group.Pattern)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if group.Position == PositionStart {
+			start = append(start, matcher)
+		} else {
+			end = append(end, matcher)
+		}
+	}
+
+	return start, end, nil
+}
+
+// ClassAttributePatterns turns every ClassAttributes entry into a regular
+// expression matched against whole attribute names. Names are matched
+// literally, `*` matches any part of a name (`data-*-class`) and `re:` entries
+// are regular expressions.
+func (config *Config) ClassAttributePatterns() ([]string, error) {
+	patterns := make([]string, 0, len(config.ClassAttributes))
+	for _, entry := range config.ClassAttributes {
+		switch {
+		case strings.HasPrefix(entry, regexEntryPrefix):
+			pattern := strings.TrimPrefix(entry, regexEntryPrefix)
+			if _, err := regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("invalid class attribute %q: %w", entry, err)
+			}
+			patterns = append(patterns, pattern)
+		case strings.Trim(entry, "*") == "":
+			return nil, fmt.Errorf("invalid class attribute %q: use `name`, a glob such as `data-*-class` or `re:<pattern>`", entry)
+		default:
+			parts := strings.Split(entry, "*")
+			for idx, part := range parts {
+				parts[idx] = regexp.QuoteMeta(part)
+			}
+			patterns = append(patterns, strings.Join(parts, ".+"))
+		}
+	}
+
+	return patterns, nil
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestClassAttributePatterns(t *testing.T) {
+	tests := []struct {
+		entry   string
+		matches []string
+		misses  []string
+	}{
+		{
+			entry:   "class",
+			matches: []string{"class"},
+			misses:  []string{"data-class", "subclass", "hx-class", "classes"},
+		},
+		{
+			entry:   "[class]",
+			matches: []string{"[class]"},
+			misses:  []string{"c", "class"},
+		},
+		{
+			entry:   "x-bind:class",
+			matches: []string{"x-bind:class"},
+			misses:  []string{"x-bind-class", "x-bindxclass"},
+		},
+		{
+			entry:   "x-transition:*",
+			matches: []string{"x-transition:enter", "x-transition:leave-end"},
+			misses:  []string{"x-transition", "x-transition:", "x-transition-enter"},
+		},
+		{
+			entry:   "*-class",
+			matches: []string{"data-class", "data-foo-active-class"},
+			misses:  []string{"class", "-class", "data-classes"},
+		},
+		{
+			entry:   "data-*-classes",
+			matches: []string{"data-toggle-classes", "data-a-b-classes"},
+			misses:  []string{"data--classes", "data-classes"},
+		},
+		{
+			entry:   "re:^(?:ng-)?class$",
+			matches: []string{"class", "ng-class"},
+			misses:  []string{"v-class", "ng-classes"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.entry, func(t *testing.T) {
+			config := &Config{ClassAttributes: []string{test.entry}}
+			patterns, err := config.ClassAttributePatterns()
+			if err != nil {
+				t.Fatalf("ClassAttributePatterns: %v", err)
+			}
+			regex := regexp.MustCompile(`^(?:` + patterns[0] + `)$`)
+
+			for _, name := range test.matches {
+				if !regex.MatchString(name) {
+					t.Errorf("%q does not match %q", test.entry, name)
+				}
+			}
+			for _, name := range test.misses {
+				if regex.MatchString(name) {
+					t.Errorf("%q matches %q", test.entry, name)
+				}
+			}
+		})
+	}
+}
+
+func TestClassAttributePatternsInvalid(t *testing.T) {
+	for _, entry := range []string{"*", "**", "re:[class"} {
+		config := &Config{ClassAttributes: []string{entry}}
+		if _, err := config.ClassAttributePatterns(); err == nil {
+			t.Errorf("ClassAttributePatterns(%q) returned no error", entry)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package config
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// mergeClassOrder prepends, appends and inserts the user entries, in that
+// order. A user class order has already replaced the preset one.
+func (config *Config) mergeClassOrder(userConfig *UserConfig) error {
+	config.ClassOrder = slices.Concat(userConfig.ClassOrderPrepend, config.ClassOrder, userConfig.ClassOrderAppend)
+
+	for _, insert := range userConfig.ClassOrderInsert {
+		if err := config.insertClassOrder(insert.Before, insert.After, insert.Entries...); err != nil {
+			return fmt.Errorf("class_order_insert: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// mergeCustomGroups keeps the groups placed at the start or the end of the
+// class list and inserts the ones placed next to a class order entry into the
+// class order.
+func (config *Config) mergeCustomGroups(customGroups []CustomGroup) error {
+	names := make(map[string]struct{}, len(customGroups))
+	for _, group := range customGroups {
+		if group.Name == "" {
+			return fmt.Errorf("custom_groups: every group needs a name")
+		}
+		if _, exists := names[group.Name]; exists {
+			return fmt.Errorf("custom_groups: duplicate group %q", group.Name)
+		}
+		names[group.Name] = struct{}{}
+
+		entry := regexEntryPrefix + group.Pattern
+		if _, err := ParseOrderEntry(entry); err != nil || group.Pattern == "" {
+			return fmt.Errorf("custom_groups: %s: invalid pattern %q", group.Name, group.Pattern)
+		}
+
+		switch {
+		case group.Position != "" && (group.Before != "" || group.After != ""):
+			return fmt.Errorf("custom_groups: %s: set either position or before/after", group.Name)
+		case group.Position == PositionStart || group.Position == PositionEnd:
+			config.CustomGroups = append(config.CustomGroups, group)
+		case group.Position != "":
+			return fmt.Errorf("custom_groups: %s: position must be %q or %q", group.Name, PositionStart, PositionEnd)
+		default:
+			if err := config.insertClassOrder(group.Before, group.After, entry); err != nil {
+				return fmt.Errorf("custom_groups: %s: %w", group.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (config *Config) insertClassOrder(before, after string, entries ...string) error {
+	anchor, insertAfter, err := insertAnchor(before, after)
+	if err != nil {
+		return err
+	}
+
+	idx := slices.Index(config.ClassOrder, anchor)
+	if idx == -1 {
+		return fmt.Errorf("unknown anchor %q", anchor)
+	}
+	if insertAfter {
+		idx++
+	}
+	config.ClassOrder = slices.Insert(config.ClassOrder, idx, entries...)
+
+	return nil
+}
+
+func (config *Config) validateClassOrder() error {
+	seen := make(map[string]struct{}, len(config.ClassOrder))
+	for _, entry := range config.ClassOrder {
+		if _, exists := seen[entry]; exists {
+			return fmt.Errorf("class_order: duplicate entry %q", entry)
+		}
+		seen[entry] = struct{}{}
+
+		if _, err := ParseOrderEntry(entry); err != nil {
+			return fmt.Errorf("class_order: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// mergeVariantOrder ranks the user variants. Variants ranked relative to
+// another one are inserted into the ordered list of variants, which is then
+// ranked again so that every variant keeps its room for breakpoints and custom
+// variants.
+func (config *Config) mergeVariantOrder(variantOrder map[string]VariantOrderEntry) error {
+	if len(variantOrder) == 0 {
+		return nil
+	}
+
+	minOrder := config.VariantOrder["min-*"]
+	isBreakpoint := func(name string) bool {
+		order, ok := config.VariantOrder[name]
+		return ok && order > minOrder && order < minOrder+variantOrderStep
+	}
+
+	variants := slices.Collect(maps.Keys(config.VariantOrder))
+	variants = slices.DeleteFunc(variants, isBreakpoint)
+	slices.SortFunc(variants, func(a, b string) int {
+		return config.VariantOrder[a] - config.VariantOrder[b]
+	})
+
+	// Rank the variants in a stable order so that errors and ranks do not
+	// depend on map iteration.
+	names := slices.Sorted(maps.Keys(variantOrder))
+	for _, name := range names {
+		entry := variantOrder[name]
+		if entry.Breakpoint == "" {
+			continue
+		}
+		if entry.Before != "" || entry.After != "" {
+			return fmt.Errorf("%s: a breakpoint cannot also set before or after", name)
+		}
+		if _, ok := parseCSSLength(entry.Breakpoint); !ok {
+			return fmt.Errorf("%s: invalid breakpoint width %q", name, entry.Breakpoint)
+		}
+		variants = slices.DeleteFunc(variants, func(variant string) bool { return variant == name })
+		config.Theme.Breakpoints[name] = entry.Breakpoint
+	}
+
+	pending := slices.DeleteFunc(names, func(name string) bool { return variantOrder[name].Breakpoint != "" })
+	for _, name := range pending {
+		if _, exists := config.VariantOrder[name]; !exists {
+			config.CustomVariants[name] = struct{}{}
+		}
+		variants = slices.DeleteFunc(variants, func(variant string) bool { return variant == name })
+		delete(config.Theme.Breakpoints, name)
+	}
+
+	// Variants may be anchored to other user variants, so keep placing the
+	// ones whose anchor is known until no progress is made.
+	for len(pending) > 0 {
+		placed := 0
+		for idx := 0; idx < len(pending); idx++ {
+			name := pending[idx]
+			entry := variantOrder[name]
+
+			anchor, after, err := insertAnchor(entry.Before, entry.After)
+			switch {
+			case err != nil && entry.Before == "" && entry.After == "":
+				variants = append(variants, name)
+			case err != nil:
+				return fmt.Errorf("%s: %w", name, err)
+			case anchor == name:
+				return fmt.Errorf("%s: a variant cannot be ranked relative to itself", name)
+			case isBreakpoint(anchor) || config.Theme.Breakpoints[anchor] != "":
+				return fmt.Errorf("%s: %q is a breakpoint, rank breakpoints with `breakpoint` instead", name, anchor)
+			default:
+				position := slices.Index(variants, anchor)
+				if position == -1 {
+					continue
+				}
+				if after {
+					position++
+				}
+				variants = slices.Insert(variants, position, name)
+			}
+
+			pending = slices.Delete(pending, idx, idx+1)
+			idx--
+			placed++
+		}
+
+		if placed == 0 {
+			return fmt.Errorf("%s: unknown anchor %q", pending[0], cmp.Or(variantOrder[pending[0]].Before, variantOrder[pending[0]].After))
+		}
+	}
+
+	config.VariantOrder = rankVariants(variants)
+	config.rankBreakpoints()
+
+	return nil
+}
+
+// insertAnchor returns the entry to insert next to and whether to insert
+// after it. Exactly one of before and after must be set.
+func insertAnchor(before, after string) (string, bool, error) {
+	switch {
+	case before != "" && after != "":
+		return "", false, fmt.Errorf("set either before or after, not both")
+	case before != "":
+		return before, false, nil
+	case after != "":
+		return after, true, nil
+	}
+
+	return "", false, fmt.Errorf("set before or after")
+}
@@ -0,0 +1,232 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Preset bundles the class order and variants of a framework or component
+// library.
+type Preset struct {
+	// Group keeps presets that describe versions of the same library, such as
+	// daisyui-v4 and daisyui-v5, from being used together.
+	Group string
+	// Variants replaces the variant order when Base is set. Otherwise the
+	// variants are ranked after the ones known so far.
+	Variants         []string
+	Base             bool
+	VariantSemantics string
+	ClassOrder       []string
+}
+
+var defaultPresets []string = []string{"tailwind-v4", "daisyui-v5"}
+
+var presets map[string]func() Preset = map[string]func() Preset{
+	"tailwind-v3": func() Preset {
+		return Preset{
+			Group: "tailwind", Variants: tailwindV3Variants(), Base: true, VariantSemantics: VariantSemanticsV3,
+			ClassOrder: tailwindClassOrder(),
+		}
+	},
+	"tailwind-v4": func() Preset {
+		return Preset{
+			Group: "tailwind", Variants: tailwindV4Variants(), Base: true, VariantSemantics: VariantSemanticsV4,
+			ClassOrder: tailwindClassOrder(),
+		}
+	},
+	"daisyui-v4": func() Preset {
+		return Preset{Group: "daisyui", ClassOrder: daisyUIClassOrder(daisyUIV4Components())}
+	},
+	"daisyui-v5": func() Preset {
+		return Preset{Group: "daisyui", Variants: daisyUIV5Variants(), ClassOrder: daisyUIClassOrder(daisyUIV5Components())}
+	},
+	"flowbite": func() Preset {
+		return Preset{Group: "flowbite", ClassOrder: flowbiteComponents()}
+	},
+	"preline": func() Preset {
+		return Preset{Group: "preline", Variants: prelineVariants(), ClassOrder: prelineComponents()}
+	},
+}
+
+// applyPresets composes the presets in the given order: component libraries
+// are ranked before the Tailwind fallback entries, like Tailwind emits its
+// components layer before its utilities.
+func (config *Config) applyPresets(names []string) error {
+	var variants, classOrder, fallback []string
+
+	config.daisyUIEntries = make(map[string]struct{})
+	groups := make(map[string]string, len(names))
+	for _, name := range names {
+		newPreset, ok := presets[name]
+		if !ok {
+			return fmt.Errorf("unknown preset %q, expected one of %s", name, strings.Join(slices.Sorted(maps.Keys(presets)), ", "))
+		}
+
+		preset := newPreset()
+		if other, exists := groups[preset.Group]; exists {
+			return fmt.Errorf("presets %q and %q cannot be used together", other, name)
+		}
+		groups[preset.Group] = name
+
+		if preset.Base {
+			config.VariantSemantics = preset.VariantSemantics
+			variants = slices.Concat(preset.Variants, variants)
+			fallback = append(fallback, preset.ClassOrder...)
+			continue
+		}
+		variants = append(variants, preset.Variants...)
+		classOrder = append(classOrder, preset.ClassOrder...)
+
+		if preset.Group == "daisyui" {
+			for _, entry := range preset.ClassOrder {
+				config.daisyUIEntries[entry] = struct{}{}
+			}
+		}
+	}
+
+	if _, ok := groups["tailwind"]; !ok {
+		return fmt.Errorf("presets must include tailwind-v3 or tailwind-v4")
+	}
+
+	config.VariantOrder = rankVariants(variants)
+	config.ClassOrder = slices.Concat(classOrder, fallback)
+	config.rankBreakpoints()
+
+	return nil
+}
+
+// pluginClassOrder lists the component classes of the official Tailwind
+// plugins, which are not Tailwind utilities.
+var pluginClassOrder map[string][]string = map[string][]string{
+	"@tailwindcss/typography": {"prose", "prose-*", "not-prose"},
+	"@tailwindcss/forms": {
+		"form-input", "form-textarea", "form-select", "form-multiselect", "form-checkbox", "form-radio",
+	},
+}
+
+// applyPlugins ranks the components of the plugins the project loads before
+// the other components, and warns when daisyUI is loaded without one of its
+// presets.
+func (config *Config) applyPlugins() {
+	var classOrder []string
+	for _, plugin := range config.Plugins {
+		if isDaisyUIPlugin(plugin) {
+			config.DaisyUI.Enabled = true
+		}
+		for _, entry := range pluginClassOrder[plugin] {
+			if !slices.Contains(config.ClassOrder, entry) && !slices.Contains(classOrder, entry) {
+				classOrder = append(classOrder, entry)
+			}
+		}
+	}
+	config.ClassOrder = slices.Concat(classOrder, config.ClassOrder)
+
+	if config.DaisyUI.Enabled && len(config.daisyUIEntries) == 0 {
+		config.Warnings = append(config.Warnings, "daisyUI is loaded but no daisyUI preset is used, add daisyui-v4 or daisyui-v5 to presets")
+	}
+}
+
+// isDaisyUIPlugin tells whether a plugin is daisyUI, loaded by name or by path.
+func isDaisyUIPlugin(name string) bool {
+	return name == "daisyui" || strings.HasSuffix(name, "/daisyui") || strings.HasSuffix(name, "/daisyui.js")
+}
+
+// tailwindClassOrder ranks the Tailwind utilities that are not known by their
+// properties, such as the ones removed in Tailwind CSS v4.
+func tailwindClassOrder() []string {
+	return []string{
+		// Layout (Box Sizing, Display, Floats, Clear, Isolation, Object Fit/Position, Overflow, Overscroll, Position, Visibility, Z-Index)
+		"float-*", "clear-*", "object-*", "overflow-*", "overscroll-*", "top-*", "right-*", "bottom-*", "left-*",
+		"inset-*", "z-*",
+
+		// Flexbox & Grid
+		"flex-basis-*", "flex-direction-*", "flex-wrap-*", "flex-*", "order-*", "grid-cols-*", "grid-col-*",
+		"grid-rows-*", "grid-row-*", "grid-flow-*", "gap-*", "justify-*", "justify-items-*", "justify-self-*",
+		"items-*", "align-*", "place-content-*", "place-items-*", "place-self-*",
+
+		// Spacing (Padding, Margin, Space Between)
+		"p-*", "px-*", "py-*", "pt-*", "pr-*", "pb-*", "pl-*", "m-*", "mx-*", "my-*", "mt-*", "mr-*", "mb-*",
+		"ml-*", "space-*",
+
+		// Sizing (Width, Min-Width, Max-Width, Height, Min-Height, Max-Height)
+		"w-*", "min-w-*", "max-w-*", "h-*", "min-h-*", "max-h-*",
+
+		// Typography
+		"font-*", "text-*", "font-weight-*", "font-variant-numeric-*", "letter-spacing-*", "line-clamp-*",
+		"line-height-*", "text-align-*", "text-color-*", "text-decoration-*", "text-decoration-color-*",
+		"text-decoration-style-*", "text-decoration-thickness-*", "text-underline-offset-*", "text-transform-*",
+		"text-overflow-*", "text-indent-*", "vertical-align-*", "whitespace-*", "break-*", "content-*",
+
+		// Backgrounds
+		"bg-*", "bg-opacity-*", "bg-origin-*", "bg-position-*", "bg-repeat-*", "bg-size-*", "bg-image-*",
+		"gradient-to-*", "from-*", "via-*", "to-*",
+
+		// Borders
+		"rounded-*", "border-*", "border-opacity-*", "border-style-*", "divide-*", "divide-opacity-*",
+		"divide-style-*", "outline-*", "outline-offset-*", "outline-style-*", "ring-*", "ring-offset-*",
+		"ring-opacity-*",
+
+		// Effects (Box Shadow, Opacity, Mix Blend, Background Blend)
+		"shadow-*", "opacity-*", "mix-blend-*", "bg-blend-*",
+
+		// Filters (Blur, Brightness, Contrast, Drop Shadow, Grayscale, Hue Rotate, Invert, Saturate, Sepia, Backdrop)
+		"blur-*", "brightness-*", "contrast-*", "drop-shadow-*", "grayscale-*", "hue-rotate-*", "invert-*",
+		"saturate-*", "sepia-*", "backdrop-*",
+
+		// Tables
+		"border-spacing-*", "table-layout-*", "caption-side-*",
+
+		// Transitions & Animation
+		"duration-*", "ease-*", "delay-*", "animate-*",
+
+		// Transforms
+		"scale-*", "rotate-*", "translate-*", "skew-*", "transform-origin-*",
+
+		// Interactivity
+		"accent-*", "appearance-*", "cursor-*", "caret-*", "pointer-events-*", "scroll-*", "scroll-snap-*",
+		"touch-*", "will-change-*",
+
+		// SVG
+		"fill-*", "stroke-*", "stroke-width-*",
+	}
+}
+
+// flowbiteComponents lists the classes Flowbite styles in its own stylesheet.
+func flowbiteComponents() []string {
+	return []string{
+		"tooltip-arrow", "popover-arrow",
+		"datatable-wrapper", "datatable-top", "datatable-container", "datatable-table", "datatable-bottom",
+		"datatable-*",
+		"datepicker", "datepicker-picker", "datepicker-header", "datepicker-controls", "datepicker-main",
+		"datepicker-footer", "datepicker-*",
+		"apexcharts-*",
+	}
+}
+
+// prelineComponents lists the classes Preline plugins use to find the elements
+// they control.
+func prelineComponents() []string {
+	return []string{
+		"hs-accordion", "hs-accordion-group", "hs-accordion-toggle", "hs-accordion-content", "hs-accordion-*",
+		"hs-collapse", "hs-collapse-toggle", "hs-collapse-*",
+		"hs-dropdown", "hs-dropdown-toggle", "hs-dropdown-menu", "hs-dropdown-*",
+		"hs-overlay", "hs-overlay-backdrop", "hs-overlay-*",
+		"hs-removing",
+		"hs-tooltip", "hs-tooltip-toggle", "hs-tooltip-content", "hs-tooltip-*",
+		"hs-carousel", "hs-carousel-*",
+		"hs-select", "hs-select-*",
+		"hs-*",
+	}
+}
+
+// prelineVariants lists the state variants added by the Preline plugin.
+func prelineVariants() []string {
+	return []string{
+		"hs-accordion-active", "hs-collapse-open", "hs-dropdown-open", "hs-overlay-open", "hs-overlay-layout-open",
+		"hs-overlay-backdrop-open", "hs-tooltip-shown", "hs-tab-active", "hs-carousel-active", "hs-carousel-disabled",
+		"hs-selected", "hs-removing", "hs-success", "hs-error", "hs-default-mode-active", "hs-dark-mode-active",
+		"hs-auto-mode-active",
+	}
+}
@@ -0,0 +1,585 @@
+package config
+
+// defaultPropertyOrder mirrors the property order table Tailwind CSS v4 uses to
+// sort the utilities it generates (tailwindcss/src/property-order.ts).
+// Properties that are not real CSS properties are kept because Tailwind uses
+// them as sort anchors too.
+func defaultPropertyOrder() []string {
+	return []string{
+		"container-type",
+
+		"pointer-events", "visibility", "position",
+
+		"inset", "inset-inline", "inset-block", "inset-inline-start", "inset-inline-end", "top", "right", "bottom", "left",
+
+		"isolation", "z-index", "order", "grid-column", "grid-column-start", "grid-column-end", "grid-row", "grid-row-start",
+		"grid-row-end", "float", "clear",
+
+		"--tw-container-component",
+
+		"margin", "margin-inline", "margin-block", "margin-inline-start", "margin-inline-end", "margin-top", "margin-right",
+		"margin-bottom", "margin-left",
+
+		"box-sizing", "display", "field-sizing", "aspect-ratio",
+
+		"height", "max-height", "min-height", "width", "max-width", "min-width",
+
+		"flex", "flex-shrink", "flex-grow", "flex-basis",
+
+		"table-layout", "caption-side", "border-collapse", "border-spacing",
+
+		"transform-origin", "translate", "--tw-translate-x", "--tw-translate-y", "--tw-translate-z", "scale", "--tw-scale-x",
+		"--tw-scale-y", "--tw-scale-z", "--tw-scale-3d", "rotate", "--tw-rotate-x", "--tw-rotate-y", "--tw-rotate-z",
+		"--tw-skew-x", "--tw-skew-y", "transform",
+
+		"animation", "cursor", "touch-action", "--tw-pan-x", "--tw-pan-y", "--tw-pinch-zoom", "user-select", "resize",
+
+		"scroll-snap-type", "--tw-scroll-snap-strictness", "scroll-snap-align", "scroll-snap-stop", "scroll-margin",
+		"scroll-margin-inline", "scroll-margin-block", "scroll-margin-inline-start", "scroll-margin-inline-end",
+		"scroll-margin-top", "scroll-margin-right", "scroll-margin-bottom", "scroll-margin-left", "scroll-padding",
+		"scroll-padding-inline", "scroll-padding-block", "scroll-padding-inline-start", "scroll-padding-inline-end",
+		"scroll-padding-top", "scroll-padding-right", "scroll-padding-bottom", "scroll-padding-left",
+
+		"list-style-position", "list-style-type", "list-style-image", "appearance", "columns", "break-before", "break-inside",
+		"break-after",
+
+		"grid-auto-columns", "grid-auto-flow", "grid-auto-rows", "grid-template-columns", "grid-template-rows",
+		"flex-direction", "flex-wrap", "place-content", "place-items", "align-content", "align-items", "justify-content",
+		"justify-items", "gap", "column-gap", "row-gap",
+
+		"--tw-space-x-reverse", "--tw-space-y-reverse", "divide-x-width", "divide-y-width", "--tw-divide-y-reverse",
+		"divide-style", "divide-color",
+
+		"place-self", "align-self", "justify-self",
+
+		"overflow", "overflow-x", "overflow-y", "overscroll-behavior", "overscroll-behavior-x", "overscroll-behavior-y",
+		"scroll-behavior",
+
+		"border-radius", "border-start-radius", "border-end-radius", "border-top-radius", "border-right-radius",
+		"border-bottom-radius", "border-left-radius", "border-start-start-radius", "border-start-end-radius",
+		"border-end-end-radius", "border-end-start-radius", "border-top-left-radius", "border-top-right-radius",
+		"border-bottom-right-radius", "border-bottom-left-radius",
+
+		"border-width", "border-inline-width", "border-block-width", "border-inline-start-width", "border-inline-end-width",
+		"border-top-width", "border-right-width", "border-bottom-width", "border-left-width", "border-style",
+		"border-color", "border-inline-color", "border-block-color", "border-inline-start-color", "border-inline-end-color",
+		"border-top-color", "border-right-color", "border-bottom-color", "border-left-color",
+
+		"background-color", "background-image", "--tw-gradient-position", "--tw-gradient-stops", "--tw-gradient-via-stops",
+		"--tw-gradient-from", "--tw-gradient-from-position", "--tw-gradient-via", "--tw-gradient-via-position",
+		"--tw-gradient-to", "--tw-gradient-to-position",
+
+		"mask-image", "box-decoration-break", "background-size", "background-attachment", "background-clip",
+		"background-position", "background-repeat", "background-origin", "mask-composite", "mask-mode", "mask-type",
+		"mask-size", "mask-clip", "mask-position", "mask-repeat", "mask-origin",
+
+		"fill", "stroke", "stroke-width", "object-fit", "object-position",
+
+		"padding", "padding-inline", "padding-block", "padding-inline-start", "padding-inline-end", "padding-top",
+		"padding-right", "padding-bottom", "padding-left",
+
+		"text-align", "text-indent", "vertical-align", "font-family", "font-size", "line-height", "font-weight",
+		"letter-spacing", "text-wrap", "overflow-wrap", "word-break", "text-overflow", "hyphens", "white-space", "color",
+		"text-transform", "font-style", "font-stretch", "font-variant-numeric", "text-decoration-line",
+		"text-decoration-color", "text-decoration-style", "text-decoration-thickness", "text-underline-offset",
+		"-webkit-font-smoothing", "placeholder-color", "caret-color", "accent-color", "color-scheme",
+
+		"opacity", "background-blend-mode", "mix-blend-mode", "box-shadow", "--tw-shadow", "--tw-shadow-color",
+		"--tw-ring-shadow", "--tw-ring-color", "--tw-inset-shadow", "--tw-inset-shadow-color", "--tw-inset-ring-shadow",
+		"--tw-inset-ring-color", "--tw-ring-offset-width", "--tw-ring-offset-color", "outline", "outline-width",
+		"outline-style", "outline-offset", "outline-color",
+
+		"--tw-blur", "--tw-brightness", "--tw-contrast", "--tw-drop-shadow", "--tw-grayscale", "--tw-hue-rotate",
+		"--tw-invert", "--tw-saturate", "--tw-sepia", "filter", "--tw-backdrop-blur", "--tw-backdrop-brightness",
+		"--tw-backdrop-contrast", "--tw-backdrop-grayscale", "--tw-backdrop-hue-rotate", "--tw-backdrop-invert",
+		"--tw-backdrop-opacity", "--tw-backdrop-saturate", "--tw-backdrop-sepia", "backdrop-filter",
+
+		"transition-property", "transition-behavior", "transition-delay", "transition-duration",
+		"transition-timing-function", "will-change", "contain", "content", "forced-color-adjust",
+	}
+}
+
+// defaultStaticUtilities maps utilities that take no value to the CSS
+// properties they generate.
+func defaultStaticUtilities() map[string][]string {
+	utilities := map[string][]string{
+		// Layout
+		"container":         {"--tw-container-component"},
+		"@container":        {"container-type"},
+		"@container-normal": {"container-type"},
+		"sr-only":           {"position", "width", "height", "padding", "margin", "overflow", "white-space", "border-width"},
+		"not-sr-only":       {"position", "width", "height", "padding", "margin", "overflow", "white-space"},
+		"isolate":           {"isolation"},
+		"isolation-auto":    {"isolation"},
+		"box-border":        {"box-sizing"},
+		"box-content":       {"box-sizing"},
+		"table-auto":        {"table-layout"},
+		"table-fixed":       {"table-layout"},
+		"caption-top":       {"caption-side"},
+		"caption-bottom":    {"caption-side"},
+		"border-collapse":   {"border-collapse"},
+		"border-separate":   {"border-collapse"},
+		"truncate":          {"overflow", "text-overflow", "white-space"},
+
+		// Flexbox & Grid
+		"grow":        {"flex-grow"},
+		"shrink":      {"flex-shrink"},
+		"flex-grow":   {"flex-grow"},
+		"flex-shrink": {"flex-shrink"},
+
+		// Transforms
+		"transform":      {"transform"},
+		"transform-cpu":  {"transform"},
+		"transform-gpu":  {"transform"},
+		"transform-none": {"transform"},
+
+		// Interactivity
+		"resize":      {"resize"},
+		"resize-none": {"resize"},
+		"resize-x":    {"resize"},
+		"resize-y":    {"resize"},
+
+		// Backgrounds
+		"bg-none":    {"background-image"},
+		"bg-fixed":   {"background-attachment"},
+		"bg-local":   {"background-attachment"},
+		"bg-scroll":  {"background-attachment"},
+		"bg-auto":    {"background-size"},
+		"bg-cover":   {"background-size"},
+		"bg-contain": {"background-size"},
+
+		// Typography
+		"italic":               {"font-style"},
+		"not-italic":           {"font-style"},
+		"antialiased":          {"-webkit-font-smoothing"},
+		"subpixel-antialiased": {"-webkit-font-smoothing"},
+		"underline":            {"text-decoration-line"},
+		"overline":             {"text-decoration-line"},
+		"line-through":         {"text-decoration-line"},
+		"no-underline":         {"text-decoration-line"},
+		"uppercase":            {"text-transform"},
+		"lowercase":            {"text-transform"},
+		"capitalize":           {"text-transform"},
+		"normal-case":          {"text-transform"},
+		"text-ellipsis":        {"text-overflow"},
+		"text-clip":            {"text-overflow"},
+		"text-wrap":            {"text-wrap"},
+		"text-nowrap":          {"text-wrap"},
+		"text-balance":         {"text-wrap"},
+		"text-pretty":          {"text-wrap"},
+		"break-normal":         {"overflow-wrap", "word-break"},
+		"break-words":          {"overflow-wrap"},
+		"break-all":            {"word-break"},
+		"break-keep":           {"word-break"},
+		"normal-nums":          {"font-variant-numeric"},
+		"ordinal":              {"font-variant-numeric"},
+		"slashed-zero":         {"font-variant-numeric"},
+		"lining-nums":          {"font-variant-numeric"},
+		"oldstyle-nums":        {"font-variant-numeric"},
+		"proportional-nums":    {"font-variant-numeric"},
+		"tabular-nums":         {"font-variant-numeric"},
+		"diagonal-fractions":   {"font-variant-numeric"},
+		"stacked-fractions":    {"font-variant-numeric"},
+
+		// Borders
+		"border": {"border-style", "border-width"},
+
+		// Effects & Filters
+		"filter":               {"filter"},
+		"filter-none":          {"filter"},
+		"backdrop-filter":      {"backdrop-filter"},
+		"backdrop-filter-none": {"backdrop-filter"},
+
+		// Transitions
+		"transition":           {"transition-property", "transition-timing-function", "transition-duration"},
+		"transition-all":       {"transition-property", "transition-timing-function", "transition-duration"},
+		"transition-colors":    {"transition-property", "transition-timing-function", "transition-duration"},
+		"transition-opacity":   {"transition-property", "transition-timing-function", "transition-duration"},
+		"transition-shadow":    {"transition-property", "transition-timing-function", "transition-duration"},
+		"transition-transform": {"transition-property", "transition-timing-function", "transition-duration"},
+		"transition-none":      {"transition-property"},
+		"transition-normal":    {"transition-behavior"},
+		"transition-discrete":  {"transition-behavior"},
+	}
+
+	for _, display := range []string{
+		"block", "inline-block", "inline", "flex", "inline-flex", "table", "inline-table", "table-caption", "table-cell",
+		"table-column", "table-column-group", "table-footer-group", "table-header-group", "table-row-group", "table-row",
+		"flow-root", "grid", "inline-grid", "contents", "list-item", "hidden",
+	} {
+		utilities[display] = []string{"display"}
+	}
+
+	for _, position := range []string{"static", "fixed", "absolute", "relative", "sticky"} {
+		utilities[position] = []string{"position"}
+	}
+
+	for _, visibility := range []string{"visible", "invisible", "collapse"} {
+		utilities[visibility] = []string{"visibility"}
+	}
+
+	for _, direction := range []string{"row", "row-reverse", "col", "col-reverse"} {
+		utilities["flex-"+direction] = []string{"flex-direction"}
+	}
+
+	for _, wrap := range []string{"wrap", "wrap-reverse", "nowrap"} {
+		utilities["flex-"+wrap] = []string{"flex-wrap"}
+	}
+
+	for _, style := range []string{"solid", "dashed", "dotted", "double", "hidden", "none"} {
+		utilities["border-"+style] = []string{"border-style"}
+		utilities["divide-"+style] = []string{"divide-style"}
+		utilities["outline-"+style] = []string{"outline-style"}
+		utilities["decoration-"+style] = []string{"text-decoration-style"}
+	}
+	utilities["decoration-wavy"] = []string{"text-decoration-style"}
+	utilities["outline"] = []string{"outline-style", "outline-width"}
+	utilities["outline-hidden"] = []string{"outline-style"}
+
+	for _, fit := range []string{"contain", "cover", "fill", "none", "scale-down"} {
+		utilities["object-"+fit] = []string{"object-fit"}
+	}
+
+	for _, align := range []string{"left", "center", "right", "justify", "start", "end"} {
+		utilities["text-"+align] = []string{"text-align"}
+	}
+
+	for _, weight := range []string{"thin", "extralight", "light", "normal", "medium", "semibold", "bold", "extrabold", "black"} {
+		utilities["font-"+weight] = []string{"font-weight"}
+	}
+
+	for _, content := range []string{"normal", "center", "start", "end", "between", "around", "evenly", "baseline", "stretch"} {
+		utilities["content-"+content] = []string{"align-content"}
+	}
+
+	for _, position := range []string{
+		"bottom", "center", "left", "left-bottom", "left-top", "right", "right-bottom", "right-top", "top",
+		"top-left", "top-right", "bottom-left", "bottom-right",
+	} {
+		utilities["bg-"+position] = []string{"background-position"}
+	}
+
+	for _, repeat := range []string{"repeat", "no-repeat", "repeat-x", "repeat-y", "repeat-round", "repeat-space"} {
+		utilities["bg-"+repeat] = []string{"background-repeat"}
+	}
+
+	for _, box := range []string{"border", "padding", "content", "text"} {
+		utilities["bg-clip-"+box] = []string{"background-clip"}
+		utilities["bg-origin-"+box] = []string{"background-origin"}
+	}
+
+	for _, snapType := range []string{"none", "x", "y", "both", "mandatory", "proximity"} {
+		utilities["snap-"+snapType] = []string{"scroll-snap-type"}
+	}
+
+	for _, snapStop := range []string{"normal", "always"} {
+		utilities["snap-"+snapStop] = []string{"scroll-snap-stop"}
+	}
+
+	for _, listPosition := range []string{"inside", "outside"} {
+		utilities["list-"+listPosition] = []string{"list-style-position"}
+	}
+
+	return utilities
+}
+
+// defaultFunctionalUtilities maps the root of utilities that take a value
+// (`p-4`, `grid-cols-3`, `rounded-lg`) to the CSS properties they generate.
+// A root also matches on its own so that `rounded` or `shadow` resolve.
+func defaultFunctionalUtilities() map[string][]string {
+	return map[string][]string{
+		// Layout
+		"inset":          {"inset"},
+		"inset-x":        {"inset-inline"},
+		"inset-y":        {"inset-block"},
+		"start":          {"inset-inline-start"},
+		"end":            {"inset-inline-end"},
+		"top":            {"top"},
+		"right":          {"right"},
+		"bottom":         {"bottom"},
+		"left":           {"left"},
+		"isolation":      {"isolation"},
+		"z":              {"z-index"},
+		"float":          {"float"},
+		"clear":          {"clear"},
+		"columns":        {"columns"},
+		"break-before":   {"break-before"},
+		"break-inside":   {"break-inside"},
+		"break-after":    {"break-after"},
+		"box-decoration": {"box-decoration-break"},
+		"object":         {"object-position"},
+		"overflow":       {"overflow"},
+		"overflow-x":     {"overflow-x"},
+		"overflow-y":     {"overflow-y"},
+		"overscroll":     {"overscroll-behavior"},
+		"overscroll-x":   {"overscroll-behavior-x"},
+		"overscroll-y":   {"overscroll-behavior-y"},
+		"aspect":         {"aspect-ratio"},
+
+		// Flexbox & Grid
+		"order":         {"order"},
+		"col":           {"grid-column"},
+		"col-span":      {"grid-column"},
+		"col-start":     {"grid-column-start"},
+		"col-end":       {"grid-column-end"},
+		"row":           {"grid-row"},
+		"row-span":      {"grid-row"},
+		"row-start":     {"grid-row-start"},
+		"row-end":       {"grid-row-end"},
+		"flex":          {"flex"},
+		"shrink":        {"flex-shrink"},
+		"grow":          {"flex-grow"},
+		"flex-shrink":   {"flex-shrink"},
+		"flex-grow":     {"flex-grow"},
+		"basis":         {"flex-basis"},
+		"grid-cols":     {"grid-template-columns"},
+		"grid-rows":     {"grid-template-rows"},
+		"grid-flow":     {"grid-auto-flow"},
+		"auto-cols":     {"grid-auto-columns"},
+		"auto-rows":     {"grid-auto-rows"},
+		"gap":           {"gap"},
+		"gap-x":         {"column-gap"},
+		"gap-y":         {"row-gap"},
+		"justify":       {"justify-content"},
+		"justify-items": {"justify-items"},
+		"justify-self":  {"justify-self"},
+		"items":         {"align-items"},
+		"self":          {"align-self"},
+		"place-content": {"place-content"},
+		"place-items":   {"place-items"},
+		"place-self":    {"place-self"},
+
+		// Spacing
+		"m":       {"margin"},
+		"mx":      {"margin-inline"},
+		"my":      {"margin-block"},
+		"ms":      {"margin-inline-start"},
+		"me":      {"margin-inline-end"},
+		"mt":      {"margin-top"},
+		"mr":      {"margin-right"},
+		"mb":      {"margin-bottom"},
+		"ml":      {"margin-left"},
+		"p":       {"padding"},
+		"px":      {"padding-inline"},
+		"py":      {"padding-block"},
+		"ps":      {"padding-inline-start"},
+		"pe":      {"padding-inline-end"},
+		"pt":      {"padding-top"},
+		"pr":      {"padding-right"},
+		"pb":      {"padding-bottom"},
+		"pl":      {"padding-left"},
+		"space-x": {"--tw-space-x-reverse", "margin-inline-start", "margin-inline-end"},
+		"space-y": {"--tw-space-y-reverse", "margin-block-start", "margin-block-end"},
+
+		// Sizing
+		"size":  {"width", "height"},
+		"w":     {"width"},
+		"min-w": {"min-width"},
+		"max-w": {"max-width"},
+		"h":     {"height"},
+		"min-h": {"min-height"},
+		"max-h": {"max-height"},
+
+		// Typography
+		"font":             {"font-family"},
+		"text":             {"font-size"},
+		"leading":          {"line-height"},
+		"tracking":         {"letter-spacing"},
+		"indent":           {"text-indent"},
+		"align":            {"vertical-align"},
+		"whitespace":       {"white-space"},
+		"hyphens":          {"hyphens"},
+		"line-clamp":       {"overflow", "display"},
+		"list":             {"list-style-type"},
+		"list-image":       {"list-style-image"},
+		"decoration":       {"text-decoration-color"},
+		"underline-offset": {"text-underline-offset"},
+		"content":          {"content"},
+		"placeholder":      {"placeholder-color"},
+		"caret":            {"caret-color"},
+		"accent":           {"accent-color"},
+		"scheme":           {"color-scheme"},
+
+		// Backgrounds
+		"bg":             {"background-color"},
+		"bg-linear":      {"background-image"},
+		"bg-radial":      {"background-image"},
+		"bg-conic":       {"background-image"},
+		"bg-gradient-to": {"background-image"},
+		"bg-size":        {"background-size"},
+		"bg-position":    {"background-position"},
+		"from":           {"--tw-gradient-from", "--tw-gradient-stops"},
+		"via":            {"--tw-gradient-via", "--tw-gradient-via-stops"},
+		"to":             {"--tw-gradient-to", "--tw-gradient-stops"},
+
+		// Borders
+		"rounded":          {"border-radius"},
+		"rounded-s":        {"border-start-radius"},
+		"rounded-e":        {"border-end-radius"},
+		"rounded-t":        {"border-top-radius"},
+		"rounded-r":        {"border-right-radius"},
+		"rounded-b":        {"border-bottom-radius"},
+		"rounded-l":        {"border-left-radius"},
+		"rounded-ss":       {"border-start-start-radius"},
+		"rounded-se":       {"border-start-end-radius"},
+		"rounded-ee":       {"border-end-end-radius"},
+		"rounded-es":       {"border-end-start-radius"},
+		"rounded-tl":       {"border-top-left-radius"},
+		"rounded-tr":       {"border-top-right-radius"},
+		"rounded-br":       {"border-bottom-right-radius"},
+		"rounded-bl":       {"border-bottom-left-radius"},
+		"border":           {"border-style", "border-width"},
+		"border-x":         {"border-style", "border-inline-width"},
+		"border-y":         {"border-style", "border-block-width"},
+		"border-s":         {"border-style", "border-inline-start-width"},
+		"border-e":         {"border-style", "border-inline-end-width"},
+		"border-t":         {"border-style", "border-top-width"},
+		"border-r":         {"border-style", "border-right-width"},
+		"border-b":         {"border-style", "border-bottom-width"},
+		"border-l":         {"border-style", "border-left-width"},
+		"border-spacing":   {"border-spacing"},
+		"border-spacing-x": {"border-spacing"},
+		"border-spacing-y": {"border-spacing"},
+		"divide-x":         {"divide-x-width"},
+		"divide-y":         {"divide-y-width"},
+		"divide":           {"divide-color"},
+		"outline":          {"outline-style", "outline-width"},
+		"outline-offset":   {"outline-offset"},
+		"ring":             {"--tw-ring-shadow", "box-shadow"},
+		"inset-ring":       {"--tw-inset-ring-shadow", "box-shadow"},
+		"ring-offset":      {"--tw-ring-offset-width"},
+
+		// Effects
+		"shadow":       {"--tw-shadow", "box-shadow"},
+		"inset-shadow": {"--tw-inset-shadow", "box-shadow"},
+		"opacity":      {"opacity"},
+		"mix-blend":    {"mix-blend-mode"},
+		"bg-blend":     {"background-blend-mode"},
+		"mask":         {"mask-image"},
+
+		// Filters
+		"blur":                {"--tw-blur", "filter"},
+		"brightness":          {"--tw-brightness", "filter"},
+		"contrast":            {"--tw-contrast", "filter"},
+		"drop-shadow":         {"--tw-drop-shadow", "filter"},
+		"grayscale":           {"--tw-grayscale", "filter"},
+		"hue-rotate":          {"--tw-hue-rotate", "filter"},
+		"invert":              {"--tw-invert", "filter"},
+		"saturate":            {"--tw-saturate", "filter"},
+		"sepia":               {"--tw-sepia", "filter"},
+		"backdrop-blur":       {"--tw-backdrop-blur", "backdrop-filter"},
+		"backdrop-brightness": {"--tw-backdrop-brightness", "backdrop-filter"},
+		"backdrop-contrast":   {"--tw-backdrop-contrast", "backdrop-filter"},
+		"backdrop-grayscale":  {"--tw-backdrop-grayscale", "backdrop-filter"},
+		"backdrop-hue-rotate": {"--tw-backdrop-hue-rotate", "backdrop-filter"},
+		"backdrop-invert":     {"--tw-backdrop-invert", "backdrop-filter"},
+		"backdrop-opacity":    {"--tw-backdrop-opacity", "backdrop-filter"},
+		"backdrop-saturate":   {"--tw-backdrop-saturate", "backdrop-filter"},
+		"backdrop-sepia":      {"--tw-backdrop-sepia", "backdrop-filter"},
+
+		// Transitions & Animation
+		"transition": {"transition-property", "transition-timing-function", "transition-duration"},
+		"duration":   {"transition-duration"},
+		"ease":       {"transition-timing-function"},
+		"delay":      {"transition-delay"},
+		"animate":    {"animation"},
+
+		// Transforms
+		"origin":      {"transform-origin"},
+		"translate":   {"translate"},
+		"translate-x": {"--tw-translate-x", "translate"},
+		"translate-y": {"--tw-translate-y", "translate"},
+		"translate-z": {"--tw-translate-z", "translate"},
+		"scale":       {"--tw-scale-x", "--tw-scale-y", "--tw-scale-z", "scale"},
+		"scale-x":     {"--tw-scale-x", "scale"},
+		"scale-y":     {"--tw-scale-y", "scale"},
+		"scale-z":     {"--tw-scale-z", "scale"},
+		"rotate":      {"rotate"},
+		"rotate-x":    {"--tw-rotate-x", "transform"},
+		"rotate-y":    {"--tw-rotate-y", "transform"},
+		"rotate-z":    {"--tw-rotate-z", "transform"},
+		"skew":        {"--tw-skew-x", "--tw-skew-y", "transform"},
+		"skew-x":      {"--tw-skew-x", "transform"},
+		"skew-y":      {"--tw-skew-y", "transform"},
+
+		// Interactivity
+		"appearance":          {"appearance"},
+		"cursor":              {"cursor"},
+		"pointer-events":      {"pointer-events"},
+		"select":              {"user-select"},
+		"touch":               {"touch-action"},
+		"scroll":              {"scroll-behavior"},
+		"snap":                {"scroll-snap-align"},
+		"scroll-m":            {"scroll-margin"},
+		"scroll-mx":           {"scroll-margin-inline"},
+		"scroll-my":           {"scroll-margin-block"},
+		"scroll-ms":           {"scroll-margin-inline-start"},
+		"scroll-me":           {"scroll-margin-inline-end"},
+		"scroll-mt":           {"scroll-margin-top"},
+		"scroll-mr":           {"scroll-margin-right"},
+		"scroll-mb":           {"scroll-margin-bottom"},
+		"scroll-ml":           {"scroll-margin-left"},
+		"scroll-p":            {"scroll-padding"},
+		"scroll-px":           {"scroll-padding-inline"},
+		"scroll-py":           {"scroll-padding-block"},
+		"scroll-ps":           {"scroll-padding-inline-start"},
+		"scroll-pe":           {"scroll-padding-inline-end"},
+		"scroll-pt":           {"scroll-padding-top"},
+		"scroll-pr":           {"scroll-padding-right"},
+		"scroll-pb":           {"scroll-padding-bottom"},
+		"scroll-pl":           {"scroll-padding-left"},
+		"will-change":         {"will-change"},
+		"contain":             {"contain"},
+		"forced-color-adjust": {"forced-color-adjust"},
+
+		// SVG
+		"fill":   {"fill"},
+		"stroke": {"stroke"},
+	}
+}
+
+// defaultValueUtilities lists the functional utilities that set a different
+// property depending on the kind of value they are given, e.g. `text-lg` sets
+// the font size while `text-red-500` sets the color. Values of any other kind
+// keep the properties from defaultFunctionalUtilities.
+func defaultValueUtilities() map[string]map[string][]string {
+	utilities := map[string]map[string][]string{
+		"text":         {"color": {"color"}},
+		"font":         {"number": {"font-weight"}},
+		"bg":           {"image": {"background-image"}, "position": {"background-position"}, "size": {"background-size"}},
+		"from":         {"percentage": {"--tw-gradient-from-position"}},
+		"via":          {"percentage": {"--tw-gradient-via-position"}},
+		"to":           {"percentage": {"--tw-gradient-to-position"}},
+		"divide":       {"length": {"divide-x-width", "divide-y-width"}, "number": {"divide-x-width", "divide-y-width"}},
+		"outline":      {"color": {"outline-color"}},
+		"ring":         {"color": {"--tw-ring-color"}},
+		"inset-ring":   {"color": {"--tw-inset-ring-color"}},
+		"ring-offset":  {"color": {"--tw-ring-offset-color"}},
+		"shadow":       {"color": {"--tw-shadow-color"}},
+		"inset-shadow": {"color": {"--tw-inset-shadow-color"}},
+		"decoration":   {"length": {"text-decoration-thickness"}, "number": {"text-decoration-thickness"}},
+		"stroke":       {"length": {"stroke-width"}, "number": {"stroke-width"}},
+	}
+
+	for side, property := range map[string]string{
+		"": "border-color", "x": "border-inline-color", "y": "border-block-color", "s": "border-inline-start-color",
+		"e": "border-inline-end-color", "t": "border-top-color", "r": "border-right-color", "b": "border-bottom-color",
+		"l": "border-left-color",
+	} {
+		root := "border"
+		if side != "" {
+			root += "-" + side
+		}
+		utilities[root] = map[string][]string{"color": {property}}
+	}
+
+	return utilities
+}
+
+// defaultModifierUtilities lists the properties a functional utility also sets
+// when its value takes a modifier, e.g. the line height of `text-sm/6`.
+func defaultModifierUtilities() map[string][]string {
+	return map[string][]string{
+		"text": {"line-height"},
+	}
+}
@@ -0,0 +1,72 @@
+package config
+
+// variantOrderStep leaves room between two variants for the ones ranked
+// relative to them, such as breakpoints or custom variants.
+const variantOrderStep int = 100
+
+// tailwindV4Variants follows the order in which Tailwind CSS v4 registers its
+// variants. Entries ending in `-*` are functional or compound variants that
+// take a value (`data-[state=open]`, `group-hover`, `max-md`); `@*` is the
+// container query variant, which `@min-*` shares its rank with. Breakpoints
+// are ranked right after `min-*` by rankBreakpoints.
+func tailwindV4Variants() []string {
+	return []string{
+		"*", "**", "not-*", "group-*", "peer-*",
+
+		// Pseudo-elements
+		"first-letter", "first-line", "marker", "selection", "file", "placeholder", "backdrop", "details-content",
+		"before", "after",
+
+		// Pseudo-classes
+		"first", "last", "only", "odd", "even", "first-of-type", "last-of-type", "only-of-type", "visited", "target",
+		"open", "default", "checked", "indeterminate", "placeholder-shown", "autofill", "optional", "required", "valid",
+		"invalid", "user-valid", "user-invalid", "in-range", "out-of-range", "read-only", "empty", "focus-within",
+		"hover", "focus", "focus-visible", "active", "enabled", "disabled", "inert",
+
+		"in-*", "has-*", "aria-*", "data-*", "nth-*", "nth-last-*", "nth-of-type-*", "nth-last-of-type-*", "supports-*",
+
+		// Media queries
+		"motion-safe", "motion-reduce", "contrast-more", "contrast-less", "max-*", "min-*", "@max-*", "@*", "@min-*",
+		"portrait", "landscape", "ltr", "rtl", "dark", "starting", "print", "forced-colors", "inverted-colors",
+		"pointer-none", "pointer-coarse", "pointer-fine", "any-pointer-none", "any-pointer-coarse", "any-pointer-fine",
+		"noscript",
+	}
+}
+
+// tailwindV3Variants follows the order in which Tailwind CSS v3 registers its
+// variants.
+func tailwindV3Variants() []string {
+	return []string{
+		"*",
+
+		// Pseudo-elements
+		"first-letter", "first-line", "marker", "selection", "file", "placeholder", "backdrop", "before", "after",
+
+		// Pseudo-classes
+		"first", "last", "only", "odd", "even", "first-of-type", "last-of-type", "only-of-type", "visited", "target",
+		"open", "default", "checked", "indeterminate", "placeholder-shown", "autofill", "optional", "required", "valid",
+		"invalid", "in-range", "out-of-range", "read-only", "empty", "focus-within", "hover", "focus", "focus-visible",
+		"active", "enabled", "disabled", "group-*", "peer-*",
+
+		"has-*", "aria-*", "data-*", "supports-*", "ltr", "rtl", "motion-safe", "motion-reduce", "dark", "print",
+
+		// Media queries
+		"max-*", "min-*", "portrait", "landscape", "contrast-more", "contrast-less", "forced-colors",
+
+		// Container queries, added by the @tailwindcss/container-queries plugin
+		"@*",
+	}
+}
+
+// rankVariants gives each variant its rank, leaving variantOrderStep between
+// two consecutive variants.
+func rankVariants(variants []string) map[string]int {
+	variantOrder := make(map[string]int, len(variants))
+	for _, variant := range variants {
+		if _, exists := variantOrder[variant]; !exists {
+			variantOrder[variant] = (len(variantOrder) + 1) * variantOrderStep
+		}
+	}
+
+	return variantOrder
+}
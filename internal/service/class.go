@@ -0,0 +1,56 @@
+package service
+
+import (
+	"strings"
+)
+
+type parsedUtility struct {
+	Name      string
+	Modifier  string
+	Important bool
+	Negative  bool
+	// Property is set for arbitrary properties such as `[mask-type:luminance]`.
+	Property string
+}
+
+// parseUtility strips the markers that do not change which utility a class
+// uses: the important marker, leading in Tailwind CSS v3 (`!p-4`) and trailing
+// in v4 (`p-4!`), the minus sign of negative values (`-mt-4`) and the modifier
+// after the slash (`bg-red-500/50`, `text-sm/6`).
+func parseUtility(utility string) parsedUtility {
+	parsed := parsedUtility{Name: utility}
+
+	if name, ok := strings.CutPrefix(parsed.Name, "!"); ok {
+		parsed.Name, parsed.Important = name, true
+	} else if name, ok := strings.CutSuffix(parsed.Name, "!"); ok {
+		parsed.Name, parsed.Important = name, true
+	}
+
+	if name, ok := strings.CutPrefix(parsed.Name, "-"); ok && name != "" {
+		parsed.Name, parsed.Negative = name, true
+	}
+
+	parsed.Name, parsed.Modifier = splitModifier(parsed.Name)
+
+	if declaration, ok := strings.CutPrefix(parsed.Name, "["); ok && strings.HasSuffix(declaration, "]") {
+		if property, _, found := strings.Cut(declaration, ":"); found && isPropertyName(property) {
+			parsed.Property = property
+		}
+	}
+
+	return parsed
+}
+
+func isPropertyName(property string) bool {
+	if property == "" {
+		return false
+	}
+
+	for _, char := range property {
+		if (char < 'a' || char > 'z') && (char < 'A' || char > 'Z') && (char < '0' || char > '9') && char != '-' && char != '_' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"path/filepath"
+	"regexp"
+	"slices"
+)
+
+// classString is the byte range of the class list of an attribute.
+type classString struct {
+	Start int
+	End   int
+}
+
+// extractClassStrings finds the class lists of a file. HTML files are read
+// with an HTML tokenizer so that only the attributes of real elements are
+// sorted, and JSX files are walked so that the expression containers of their
+// class attributes are understood. Only the template of Vue single-file
+// components is read for class attributes. Other files are scanned for
+// anything that looks like a class attribute and for the class functions
+// called by their code.
+func (sorter *Sorter) extractClassStrings(filePath string, content []byte) []classString {
+	if slices.Contains(sorter.Config.HTMLFilePatterns, filepath.Ext(filePath)) {
+		return sorter.extractHTMLClassStrings(content)
+	}
+	if slices.Contains(sorter.Config.JSXFilePatterns, filepath.Ext(filePath)) {
+		return sorter.extractJSXClassStrings(content)
+	}
+	if slices.Contains(sorter.Config.VueFilePatterns, filepath.Ext(filePath)) {
+		return sorter.extractVueClassStrings(content)
+	}
+
+	classStrings := sorter.scanClassAttributes(content, 0, len(content))
+	if len(sorter.Config.ClassFunctions) > 0 {
+		classStrings = mergeClassStrings(classStrings, sorter.findJSClassStrings(string(content), 0, jsCode))
+	}
+
+	return classStrings
+}
+
+// attributeClassStrings returns the class strings of an attribute value: the
+// value itself or, when it calls a class function as in `cn('p-4', x)`, the
+// class strings of the call.
+func (sorter *Sorter) attributeClassStrings(value string, offset int) []classString {
+	if sorter.isClassFunctionCall(value) {
+		return sorter.findJSClassStrings(value, offset, jsCode)
+	}
+
+	return []classString{{Start: offset, End: offset + len(value)}}
+}
+
+// mergeClassStrings merges two lists of class strings in the order of the
+// file. A class string nested in another one, such as a class function
+// called in a template literal attribute, is sorted with the outer one.
+func mergeClassStrings(classStrings, others []classString) []classString {
+	merged := slices.Concat(classStrings, others)
+	slices.SortFunc(merged, func(classStringI, classStringJ classString) int {
+		if classStringI.Start != classStringJ.Start {
+			return classStringI.Start - classStringJ.Start
+		}
+		return classStringJ.End - classStringI.End
+	})
+
+	result := make([]classString, 0, len(merged))
+	for _, classString := range merged {
+		if len(result) > 0 && classString.Start < result[len(result)-1].End {
+			continue
+		}
+		result = append(result, classString)
+	}
+
+	return result
+}
+
+// attributeRegex finds anything that looks like an attribute with a quoted
+// value, which may span several lines. The name is matched from its first
+// character, so `data-class` is never read as `class`.
+var attributeRegex *regexp.Regexp = regexp.MustCompile(`(?s)(?P<name>[\w\-:.@\[\]]+)\s*=\s*(?:"(?P<double>.*?)"|'(?P<single>.*?)'|` + "`" + `(?P<backtick>.*?)` + "`" + `)`)
+
+// scanClassAttributes finds the class attributes between start and end.
+func (sorter *Sorter) scanClassAttributes(content []byte, start, end int) []classString {
+	var classStrings []classString
+
+	nameGroup := attributeRegex.SubexpIndex("name")
+	valueGroups := []int{attributeRegex.SubexpIndex("double"), attributeRegex.SubexpIndex("single"), attributeRegex.SubexpIndex("backtick")}
+	backtickGroup := valueGroups[2]
+
+	text := string(content[start:end])
+	for cursor := 0; cursor < len(text); {
+		match := attributeRegex.FindStringSubmatchIndex(text[cursor:])
+		if match == nil {
+			break
+		}
+		for idx := range match {
+			if match[idx] != -1 {
+				match[idx] += cursor
+			}
+		}
+		cursor = match[1]
+
+		// Template literals end at the backtick closing them, not at the
+		// ones of the template literals nested in their `${...}` blocks.
+		if match[2*backtickGroup] != -1 {
+			literalEnd := skipJSString(text, match[2*backtickGroup]-1)
+			if literalEnd == -1 {
+				continue
+			}
+			match[2*backtickGroup+1] = literalEnd - 1
+			cursor = literalEnd
+		}
+
+		// Other values may hold markup, as in `el.innerHTML = '<b class="...">'`
+		// in a script, so they are scanned too.
+		if !sorter.classAttributeNameRegex.MatchString(text[match[2*nameGroup]:match[2*nameGroup+1]]) {
+			cursor = match[2*nameGroup+1]
+			continue
+		}
+
+		for _, group := range valueGroups {
+			if match[2*group] != -1 {
+				classStrings = append(classStrings, sorter.attributeClassStrings(text[match[2*group]:match[2*group+1]], start+match[2*group])...)
+				break
+			}
+		}
+	}
+
+	return classStrings
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// rawTextElements hold text that the tokenizer does not read as markup.
+var rawTextElements map[string]struct{} = map[string]struct{}{
+	"script": {}, "style": {}, "textarea": {}, "title": {}, "xmp": {}, "iframe": {}, "noembed": {}, "noframes": {},
+	"noscript": {}, "plaintext": {},
+}
+
+// extractHTMLClassStrings finds the class attributes of the elements of an
+// HTML document. Comments and the text of raw text elements, such as
+// `<script>` or `<style>`, are skipped unless they are configured to be
+// scanned.
+func (sorter *Sorter) extractHTMLClassStrings(content []byte) []classString {
+	var classStrings []classString
+
+	tokenizer := html.NewTokenizer(bytes.NewReader(content))
+
+	offset, rawTextElement := 0, ""
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+
+		start := offset
+		raw := tokenizer.Raw()
+		offset += len(raw)
+
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			classStrings = append(classStrings, sorter.tagClassStrings(raw, start, false)...)
+
+			name, _ := tokenizer.TagName()
+			if _, ok := rawTextElements[string(name)]; ok && tokenType == html.StartTagToken {
+				rawTextElement = string(name)
+			}
+		case html.EndTagToken:
+			rawTextElement = ""
+		case html.CommentToken:
+			if sorter.Config.ScanComments {
+				classStrings = append(classStrings, sorter.scanClassAttributes(content, start, offset)...)
+			}
+		case html.TextToken:
+			var rawTextClassStrings []classString
+			if rawTextElement != "" && slices.Contains(sorter.Config.ScanRawText, rawTextElement) {
+				rawTextClassStrings = sorter.scanClassAttributes(content, start, offset)
+			}
+			if rawTextElement == "script" && len(sorter.Config.ClassFunctions) > 0 {
+				rawTextClassStrings = mergeClassStrings(rawTextClassStrings, sorter.findJSClassStrings(string(raw), start, jsCode))
+			}
+			classStrings = append(classStrings, rawTextClassStrings...)
+		}
+	}
+
+	return classStrings
+}
+
+// tagClassStrings finds the class attributes of a start tag. The tokenizer
+// does not tell where the attribute values are, so the raw tag is read again
+// following the attribute states of the HTML tokenizer. The attributes of Vue
+// templates may be bound to expressions.
+func (sorter *Sorter) tagClassStrings(raw []byte, offset int, vue bool) []classString {
+	var classStrings []classString
+
+	idx := 1
+	for idx < len(raw) && !isHTMLSpace(raw[idx]) && raw[idx] != '/' && raw[idx] != '>' {
+		idx++
+	}
+
+	for idx < len(raw) {
+		for idx < len(raw) && (isHTMLSpace(raw[idx]) || raw[idx] == '/') {
+			idx++
+		}
+		if idx >= len(raw) || raw[idx] == '>' {
+			break
+		}
+
+		// An attribute name may start with `=`.
+		nameStart := idx
+		for idx++; idx < len(raw) && !isHTMLSpace(raw[idx]) && raw[idx] != '/' && raw[idx] != '>' && raw[idx] != '='; idx++ {
+		}
+		name := string(raw[nameStart:idx])
+
+		// Template tags, as in `{% if x %}class="..."{% endif %}`, run into
+		// the name of the attribute that follows them.
+		if end := strings.LastIndexByte(name, '}'); end != -1 {
+			name = name[end+1:]
+		}
+
+		for idx < len(raw) && isHTMLSpace(raw[idx]) {
+			idx++
+		}
+		if idx >= len(raw) || raw[idx] != '=' {
+			continue
+		}
+		idx++
+		for idx < len(raw) && isHTMLSpace(raw[idx]) {
+			idx++
+		}
+
+		var valueStart, valueEnd int
+		if idx < len(raw) && (raw[idx] == '"' || raw[idx] == '\'') {
+			quote := raw[idx]
+			valueStart = idx + 1
+			length := bytes.IndexByte(raw[valueStart:], quote)
+			if length == -1 {
+				break
+			}
+			valueEnd = valueStart + length
+			idx = valueEnd + 1
+		} else {
+			valueStart = idx
+			for idx < len(raw) && !isHTMLSpace(raw[idx]) && raw[idx] != '>' {
+				idx++
+			}
+			valueEnd = idx
+		}
+
+		if vue {
+			classStrings = append(classStrings, sorter.vueAttributeClassStrings(name, string(raw[valueStart:valueEnd]), offset+valueStart)...)
+		} else if sorter.htmlClassAttributeNameRegex.MatchString(name) {
+			classStrings = append(classStrings, sorter.attributeClassStrings(string(raw[valueStart:valueEnd]), offset+valueStart)...)
+		}
+	}
+
+	return classStrings
+}
+
+func isHTMLSpace(char byte) bool {
+	return char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == '\f'
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/selene466/go-tailwind-sorter/internal/config"
+)
+
+func TestSortHTMLClassStrings(t *testing.T) {
+	tests := []struct {
+		name       string
+		userConfig config.UserConfig
+		content    string
+		want       string
+	}{
+		{
+			name:    "quoted values",
+			content: `<div class="p-4 flex"></div><div class='p-2 block' title="it's"></div>`,
+			want:    `<div class="flex p-4"></div><div class='block p-2' title="it's"></div>`,
+		},
+		{
+			name:    "unquoted value",
+			content: `<div class=hover:md:flex data-x="p-4 flex"></div><div class=flex></div>`,
+			want:    `<div class=md:hover:flex data-x="p-4 flex"></div><div class=flex></div>`,
+		},
+		{
+			name:    "unquoted values next to quoted ones",
+			content: `<img class=p-4 alt="x"><div id=a class="p-4 flex">`,
+			want:    `<img class=p-4 alt="x"><div id=a class="flex p-4">`,
+		},
+		{
+			name:    "uppercase attribute name",
+			content: `<DIV CLASS="p-4 flex"></DIV>`,
+			want:    `<DIV CLASS="flex p-4"></DIV>`,
+		},
+		{
+			name:    "other attributes ending in class",
+			content: `<div data-class="p-4 flex" subclass="p-4 flex" class="p-4 flex"></div>`,
+			want:    `<div data-class="p-4 flex" subclass="p-4 flex" class="flex p-4"></div>`,
+		},
+		{
+			name:    "template tags inside the start tag",
+			content: `<div {% if active %}class="p-4 flex"{% endif %} {{ attrs }}class="p-2 block"></div>`,
+			want:    `<div {% if active %}class="flex p-4"{% endif %} {{ attrs }}class="block p-2"></div>`,
+		},
+		{
+			name:    "comment",
+			content: `<!-- <div class="p-4 flex"></div> --><div class="p-4 flex"></div>`,
+			want:    `<!-- <div class="p-4 flex"></div> --><div class="flex p-4"></div>`,
+		},
+		{
+			name:       "scanned comment",
+			userConfig: config.UserConfig{ScanComments: true},
+			content:    `<!-- <div class="p-4 flex"></div> -->`,
+			want:       `<!-- <div class="flex p-4"></div> -->`,
+		},
+		{
+			name:    "script and style text",
+			content: "<script>const a = '<div class=\"p-4 flex\"></div>';</script>\n<style>/* class=\"p-4 flex\" */</style>\n<p class=\"p-4 flex\"></p>",
+			want:    "<script>const a = '<div class=\"p-4 flex\"></div>';</script>\n<style>/* class=\"p-4 flex\" */</style>\n<p class=\"flex p-4\"></p>",
+		},
+		{
+			name:       "scanned script text",
+			userConfig: config.UserConfig{ScanRawText: []string{"script"}},
+			content:    "<script>const a = '<div class=\"p-4 flex\"></div>';</script><style>.a { } /* class=\"p-4 flex\" */</style>",
+			want:       "<script>const a = '<div class=\"flex p-4\"></div>';</script><style>.a { } /* class=\"p-4 flex\" */</style>",
+		},
+		{
+			name:    "markup in a script string",
+			content: "<script>const a = \"</div><div class='p-4 flex'>\";</script><div class=\"p-4 flex\"></div>",
+			want:    "<script>const a = \"</div><div class='p-4 flex'>\";</script><div class=\"flex p-4\"></div>",
+		},
+		{
+			name:    "CRLF line endings",
+			content: "<div\r\n  class=\"p-4 flex\"\r\n  id=\"a\">\r\n<div class=\"p-2\r\n  block\"></div>\r\n</div>\r\n",
+			want:    "<div\r\n  class=\"flex p-4\"\r\n  id=\"a\">\r\n<div class=\"block p-2\"></div>\r\n</div>\r\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sorter := newTestSorter(t, test.userConfig, nil)
+			if got := sortFile(sorter, "index.html", test.content); got != test.want {
+				t.Errorf("got\n%q\nwant\n%q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSortClassAttributePatterns(t *testing.T) {
+	sorter := newTestSorter(t, config.UserConfig{ClassAttributes: []string{"class", "x-transition:*", "data-*-class"}}, nil)
+
+	content := `<div x-transition:enter="p-4 flex" x-transition="p-4 flex" data-foo-active-class="p-4 flex" data-class="p-4 flex"></div>`
+	want := `<div x-transition:enter="flex p-4" x-transition="p-4 flex" data-foo-active-class="flex p-4" data-class="p-4 flex"></div>`
+	for _, filePath := range []string{"index.html", "page.php"} {
+		if got := sortFile(sorter, filePath, content); got != want {
+			t.Errorf("%s: got\n%q\nwant\n%q", filePath, got, want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"github.com/selene466/go-tailwind-sorter/internal/config"
+)
+
+// classOrderIndex finds the first class order entry matching a class without
+// scanning every entry: exact entries are looked up in a map and family
+// entries in a prefix trie. Only regex entries are tried one by one.
+type classOrderIndex struct {
+	exact    map[string]int
+	prefixes *prefixNode
+	regexes  []indexedMatcher
+}
+
+type prefixNode struct {
+	children map[byte]*prefixNode
+	// order is the position of the first entry ending at this node, or -1.
+	order int
+}
+
+type indexedMatcher struct {
+	order   int
+	matcher config.OrderMatcher
+}
+
+func newClassOrderIndex(matchers []config.OrderMatcher) *classOrderIndex {
+	index := &classOrderIndex{
+		exact:    make(map[string]int),
+		prefixes: &prefixNode{order: -1},
+	}
+
+	for order, matcher := range matchers {
+		switch matcher.Kind {
+		case config.MatchExact:
+			if _, exists := index.exact[matcher.Value()]; !exists {
+				index.exact[matcher.Value()] = order
+			}
+		case config.MatchPrefix:
+			index.prefixes.insert(matcher.Value(), order)
+		case config.MatchRegex:
+			index.regexes = append(index.regexes, indexedMatcher{order: order, matcher: matcher})
+		}
+	}
+
+	return index
+}
+
+func (node *prefixNode) insert(prefix string, order int) {
+	for idx := 0; idx < len(prefix); idx++ {
+		child, ok := node.children[prefix[idx]]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[byte]*prefixNode)
+			}
+			child = &prefixNode{order: -1}
+			node.children[prefix[idx]] = child
+		}
+		node = child
+	}
+
+	if node.order == -1 {
+		node.order = order
+	}
+}
+
+// isExact tells whether an exact entry names the class.
+func (index *classOrderIndex) isExact(className string) bool {
+	_, ok := index.exact[className]
+	return ok
+}
+
+// lookup returns the position of the first entry matching the class.
+func (index *classOrderIndex) lookup(className string) (int, bool) {
+	order, found := index.exact[className]
+
+	// A family entry only matches classes longer than its prefix.
+	node := index.prefixes
+	for idx := 0; idx < len(className)-1; idx++ {
+		node = node.children[className[idx]]
+		if node == nil {
+			break
+		}
+		if node.order != -1 && (!found || node.order < order) {
+			order, found = node.order, true
+		}
+	}
+
+	for _, regex := range index.regexes {
+		if found && regex.order > order {
+			break
+		}
+		if regex.matcher.Match(className) {
+			order, found = regex.order, true
+			break
+		}
+	}
+
+	return order, found
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"slices"
+	"strings"
+)
+
+// skipJSBlock returns the position right after the bracket closing the block
+// starting at start, e.g. the body of a `${...}` block or the arguments of a
+// call, or -1 when it is not closed. Brackets inside strings, template
+// literals and comments do not count.
+func skipJSBlock(code string, start int) int {
+	depth := 0
+	for idx := start; idx < len(code); idx++ {
+		switch code[idx] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth == 0 {
+				return idx + 1
+			}
+			depth--
+		case '\'', '"', '`':
+			end := skipJSString(code, idx)
+			if end == -1 {
+				return -1
+			}
+			idx = end - 1
+		case '/':
+			if strings.HasPrefix(code[idx:], "//") {
+				end := strings.IndexByte(code[idx:], '\n')
+				if end == -1 {
+					return -1
+				}
+				idx += end
+			} else if strings.HasPrefix(code[idx:], "/*") {
+				end := strings.Index(code[idx+2:], "*/")
+				if end == -1 {
+					return -1
+				}
+				idx += end + 3
+			}
+		}
+	}
+
+	return -1
+}
+
+// skipJSString returns the position right after the string or template
+// literal starting at start, or -1 when it is not closed.
+func skipJSString(code string, start int) int {
+	quote := code[start]
+	for idx := start + 1; idx < len(code); idx++ {
+		switch {
+		case code[idx] == '\\':
+			idx++
+		case code[idx] == quote:
+			return idx + 1
+		case quote == '`' && strings.HasPrefix(code[idx:], "${"):
+			end := skipJSBlock(code, idx+2)
+			if end == -1 {
+				return -1
+			}
+			idx = end - 1
+		}
+	}
+
+	return -1
+}
+
+// findInterpolation returns the position of the next `${...}` block of a
+// template literal from start and the position right after it.
+func findInterpolation(text string, start int) (int, int, bool) {
+	for idx := start; idx < len(text); idx++ {
+		switch {
+		case text[idx] == '\\':
+			idx++
+		case strings.HasPrefix(text[idx:], "${"):
+			end := skipJSBlock(text, idx+2)
+			if end == -1 {
+				return 0, 0, false
+			}
+			return idx, end, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// jsContext tells which string literals of JavaScript code hold classes.
+type jsContext int
+
+const (
+	// jsCode is plain code: only the arguments of class functions, such as
+	// `clsx(...)`, and tagged templates, such as `` tw`...` ``, hold classes.
+	jsCode jsContext = iota
+	// jsCondition is the expression of a `${...}` block: the strings picked
+	// by a condition hold classes.
+	jsCondition
+	// jsArguments are the arguments of a class function: every string that
+	// is not compared or concatenated holds classes, including object keys
+	// and the strings nested in arrays and objects.
+	jsArguments
+	// jsVariants is the `variants` object of cva: its values hold classes,
+	// its keys name variants, as in `{ size: { lg: "text-lg" } }`.
+	jsVariants
+	// jsCompoundVariants is the `compoundVariants` array of cva: only the
+	// `class` and `className` values hold classes, the other values are
+	// conditions, as in `{ size: "lg", class: "font-bold" }`.
+	jsCompoundVariants
+)
+
+// findJSClassStrings finds the string literals of JavaScript code that hold
+// classes and returns the byte ranges of their contents, offset by offset.
+func (sorter *Sorter) findJSClassStrings(code string, offset int, context jsContext) []classString {
+	var classStrings []classString
+
+	// property is the key of the object value being read.
+	previous, identifier, literal, property := "", "", "", ""
+	for idx := 0; idx < len(code); idx++ {
+		char := code[idx]
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r':
+			continue
+		case strings.HasPrefix(code[idx:], "//"):
+			end := strings.IndexByte(code[idx:], '\n')
+			if end == -1 {
+				return classStrings
+			}
+			idx += end
+			continue
+		case strings.HasPrefix(code[idx:], "/*"):
+			end := strings.Index(code[idx+2:], "*/")
+			if end == -1 {
+				return classStrings
+			}
+			idx += end + 3
+			continue
+		case char == '\'' || char == '"' || char == '`':
+			end := skipJSString(code, idx)
+			if end == -1 {
+				return classStrings
+			}
+
+			literal = code[idx+1 : end-1]
+			tagged := char == '`' && previous == "identifier" && sorter.isClassFunction(identifier)
+			next := strings.TrimLeft(code[end:], " \t\n\r")
+			switch {
+			case strings.Contains(literal, "\\"):
+			case tagged || isClassLiteral(previous, next, property, context):
+				classStrings = append(classStrings, classString{Start: offset + idx + 1, End: offset + end - 1})
+			case char == '`':
+				// Class functions may be called inside the blocks of other
+				// template literals.
+				for cursor := 0; ; {
+					start, blockEnd, found := findInterpolation(literal, cursor)
+					if !found {
+						break
+					}
+					classStrings = append(classStrings, sorter.findJSClassStrings(literal[start+2:blockEnd-1], offset+idx+1+start+2, jsCode)...)
+					cursor = blockEnd
+				}
+			}
+
+			previous, identifier = "literal", ""
+			idx = end - 1
+		case char == '(' || char == '[' || char == '{':
+			end := skipJSBlock(code, idx+1)
+			if end == -1 {
+				return classStrings
+			}
+
+			// Arrays, objects and grouping parentheses keep the context,
+			// the arguments of a call only hold classes for class functions.
+			innerContext := context
+			switch {
+			case char == '(' && previous == "identifier" && sorter.isClassFunction(identifier):
+				innerContext = jsArguments
+			case char == '(' && isJSValueEnd(code[:idx]):
+				innerContext = jsCode
+			case context == jsArguments && previous == ":" && property == "variants":
+				innerContext = jsVariants
+			case context == jsArguments && previous == ":" && property == "compoundVariants":
+				innerContext = jsCompoundVariants
+			case context == jsArguments && previous == ":" && property == "defaultVariants":
+				innerContext = jsCode
+			case context == jsCompoundVariants && isClassProperty(property):
+				innerContext = jsArguments
+			}
+			classStrings = append(classStrings, sorter.findJSClassStrings(code[idx+1:end-1], offset+idx+1, innerContext)...)
+
+			previous, identifier = code[end-1:end], ""
+			idx = end - 1
+		case strings.HasPrefix(code[idx:], "&&"), strings.HasPrefix(code[idx:], "||"), strings.HasPrefix(code[idx:], "??"):
+			previous, identifier = code[idx:idx+2], ""
+			idx++
+		case isJSIdentifierChar(char):
+			start := idx
+			for idx+1 < len(code) && isJSIdentifierChar(code[idx+1]) {
+				idx++
+			}
+			previous, identifier = "identifier", code[start:idx+1]
+		default:
+			switch {
+			case char == ':' && previous == "identifier":
+				property = identifier
+			case char == ':' && previous == "literal":
+				property = literal
+			case char == ',':
+				property = ""
+			}
+			previous, identifier = string(char), ""
+		}
+	}
+
+	return classStrings
+}
+
+// isClassLiteral tells whether a string literal between the given tokens holds
+// classes in the context, given the key of the object value it belongs to.
+func isClassLiteral(previous, next, property string, context jsContext) bool {
+	switch context {
+	case jsCode:
+		return false
+	case jsVariants:
+		// Keys name the values of a variant, as in `{ "2xl": "text-2xl" }`.
+		if (previous == "" || previous == ",") && strings.HasPrefix(next, ":") {
+			return false
+		}
+	case jsCompoundVariants:
+		if !isClassProperty(property) {
+			return false
+		}
+	}
+
+	return isClassOperand(previous, next, context)
+}
+
+// isClassProperty tells whether an object key holds classes in cva options.
+func isClassProperty(property string) bool {
+	return property == "class" || property == "className"
+}
+
+// isClassFunction tells whether a name, or the object it is a member of as
+// in `tw.div`, is one of the class functions.
+func (sorter *Sorter) isClassFunction(name string) bool {
+	object, _, _ := strings.Cut(name, ".")
+	return slices.Contains(sorter.Config.ClassFunctions, name) || slices.Contains(sorter.Config.ClassFunctions, object)
+}
+
+// isClassFunctionCall tells whether an attribute value is a call to a class
+// function, such as `cn('p-4', isActive && 'font-bold')`.
+func (sorter *Sorter) isClassFunctionCall(value string) bool {
+	value = strings.TrimSpace(value)
+
+	end := 0
+	for end < len(value) && isJSIdentifierChar(value[end]) {
+		end++
+	}
+	rest := strings.TrimLeft(value[end:], " \t\n\r")
+
+	return end > 0 && sorter.isClassFunction(value[:end]) && (strings.HasPrefix(rest, "(") || strings.HasPrefix(rest, "`"))
+}
+
+// sortInterpolations sorts the class strings inside the `${...}` blocks of a
+// class, keeping the rest of the class untouched.
+func (sorter *Sorter) sortInterpolations(twClass string) string {
+	var result strings.Builder
+
+	cursor := 0
+	for {
+		start, end, found := findInterpolation(twClass, cursor)
+		if !found {
+			break
+		}
+
+		result.WriteString(twClass[cursor : start+2])
+		result.WriteString(sorter.sortJSExpression(twClass[start+2 : end-1]))
+		result.WriteString("}")
+		cursor = end
+	}
+	result.WriteString(twClass[cursor:])
+
+	return result.String()
+}
+
+// sortJSExpression sorts the string literals of an expression that are picked
+// by a condition, such as both branches of `isOpen ? 'block' : 'hidden'` or
+// the right side of `isActive && 'font-bold'`, and the arguments of class
+// functions. Other literals, such as the ones compared in `size === 'lg'`, are
+// left untouched.
+func (sorter *Sorter) sortJSExpression(expression string) string {
+	var result strings.Builder
+
+	cursor := 0
+	for _, classString := range sorter.findJSClassStrings(expression, 0, jsCondition) {
+		result.WriteString(expression[cursor:classString.Start])
+		result.WriteString(sorter.sortTWClassString(sorter.normalizeTWClassString(expression[classString.Start:classString.End])))
+		cursor = classString.End
+	}
+	result.WriteString(expression[cursor:])
+
+	return result.String()
+}
+
+// isClassOperand tells whether a string literal between the given tokens is
+// picked by a condition, or passed to a class function, rather than compared
+// or concatenated.
+func isClassOperand(previous, next string, context jsContext) bool {
+	switch previous {
+	case "", "?", ":", "&&", "||", "??":
+	case ",":
+		if context == jsCondition {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if next == "" {
+		return true
+	}
+	for _, operator := range []string{":", ",", "&&", "||", "??"} {
+		if strings.HasPrefix(next, operator) {
+			// `??=` and `||=` assign rather than pick.
+			return !strings.HasPrefix(next[len(operator):], "=") || operator == ":"
+		}
+	}
+
+	return false
+}
+
+// isJSValueEnd tells whether the code ends with a value, such as a name or a
+// closing bracket, rather than with an operator.
+func isJSValueEnd(code string) bool {
+	code = strings.TrimRight(code, " \t\n\r")
+	if code == "" {
+		return false
+	}
+
+	last := code[len(code)-1]
+	return isJSIdentifierChar(last) || last == ')' || last == ']'
+}
+
+func isJSIdentifierChar(char byte) bool {
+	return char == '_' || char == '$' || char == '.' || isDigit(char) || (char >= 'a' && char <= 'z') ||
+		(char >= 'A' && char <= 'Z')
+}
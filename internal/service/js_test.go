@@ -0,0 +1,126 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/selene466/go-tailwind-sorter/internal/config"
+)
+
+// sortFile sorts the class strings of a file the way the worker does.
+func sortFile(sorter *Sorter, filePath, content string) string {
+	return string(sorter.processFileContent([]byte(content), sorter.extractClassStrings(filePath, []byte(content))))
+}
+
+func TestSortJSClassStrings(t *testing.T) {
+	sorter := newTestSorter(t, config.UserConfig{}, nil)
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "condition in interpolation",
+			content: "<div class=\"p-4 flex ${a ? 'p-2 block' : 'hidden'}\"></div>",
+			want:    "<div class=\"flex p-4 ${a ? 'block p-2' : 'hidden'}\"></div>",
+		},
+		{
+			name:    "template literal attribute",
+			content: "<div class=`p-4 flex ${a ? `p-2 block` : 'z'}`></div>",
+			want:    "<div class=`flex p-4 ${a ? `block p-2` : 'z'}`></div>",
+		},
+		{
+			name:    "compared literal",
+			content: "<div class=\"flex ${size === 'p-4 flex' ? 'p-2 block' : ''}\"></div>",
+			want:    "<div class=\"flex ${size === 'p-4 flex' ? 'block p-2' : ''}\"></div>",
+		},
+		{
+			name:    "concatenated literal",
+			content: "<div class=\"flex ${'p-4 flex' + suffix}\"></div>",
+			want:    "<div class=\"flex ${'p-4 flex' + suffix}\"></div>",
+		},
+		{
+			name:    "comment containing a quote",
+			content: "<div class=\"flex ${/* don't */ a ? 'p-2 block' : ''}\"></div>",
+			want:    "<div class=\"flex ${/* don't */ a ? 'block p-2' : ''}\"></div>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := sortFile(sorter, "component.js", test.content); got != test.want {
+				t.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSortClassFunctions(t *testing.T) {
+	sorter := newTestSorter(t, config.UserConfig{ClassFunctions: []string{"clsx", "cn", "cva", "tw"}}, nil)
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "class function object keys",
+			content: "const classes = clsx({ 'p-4 flex': c, 'm-2 block': !c }, 'p-2 hidden');",
+			want:    "const classes = clsx({ 'flex p-4': c, 'm-2 block': !c }, 'hidden p-2');",
+		},
+		{
+			name:    "class function compared argument",
+			content: "clsx(size === 'p-4 flex' && 'p-2 block')",
+			want:    "clsx(size === 'p-4 flex' && 'block p-2')",
+		},
+		{
+			name: "cva variants and compound variants",
+			content: `const button = cva("p-4 flex", {
+  variants: { size: { sm: "text-sm p-2", lg: ["p-6 text-lg", "font-bold block"] } },
+  compoundVariants: [{ size: "lg", class: "flex mt-2" }],
+  defaultVariants: { size: "sm" },
+});`,
+			want: `const button = cva("flex p-4", {
+  variants: { size: { sm: "p-2 text-sm", lg: ["p-6 text-lg", "block font-bold"] } },
+  compoundVariants: [{ size: "lg", class: "mt-2 flex" }],
+  defaultVariants: { size: "sm" },
+});`,
+		},
+		{
+			name:    "tagged template",
+			content: "const Title = tw.h1`p-4 flex`;",
+			want:    "const Title = tw.h1`flex p-4`;",
+		},
+		{
+			name:    "other functions",
+			content: "const text = format('p-4 flex'); const s = 'p-4 flex';",
+			want:    "const text = format('p-4 flex'); const s = 'p-4 flex';",
+		},
+		{
+			name: "cva compound variant conditions and default variants",
+			content: `const button = cva("p-4 flex", {
+  variants: { size: { "p-4 flex": "p-4 flex" } },
+  compoundVariants: [{ size: "p-4 flex", className: "p-4 flex" }],
+  defaultVariants: { size: "p-4 flex" },
+});`,
+			want: `const button = cva("flex p-4", {
+  variants: { size: { "p-4 flex": "flex p-4" } },
+  compoundVariants: [{ size: "p-4 flex", className: "flex p-4" }],
+  defaultVariants: { size: "p-4 flex" },
+});`,
+		},
+		{
+			name:    "compared literals",
+			content: "cn(size === 'p-4 flex' ? 'p-4 flex' : 'p-2 block', 'p-4 flex' !== size && 'm-2 block')",
+			want:    "cn(size === 'p-4 flex' ? 'flex p-4' : 'block p-2', 'p-4 flex' !== size && 'm-2 block')",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := sortFile(sorter, "component.js", test.content); got != test.want {
+				t.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
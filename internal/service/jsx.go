@@ -0,0 +1,266 @@
+package service
+
+import (
+	"strings"
+)
+
+// jsxKeywords may be followed by an element, unlike other names after which
+// `<` compares values or starts type arguments.
+var jsxKeywords map[string]struct{} = map[string]struct{}{
+	"return": {}, "yield": {}, "await": {}, "case": {}, "default": {}, "else": {}, "do": {}, "in": {}, "of": {},
+	"typeof": {}, "void": {},
+}
+
+// extractJSXClassStrings finds the class attributes of the JSX elements of a
+// file. Attribute values may be strings or expression containers such as
+// `className={isOpen ? "block" : "hidden"}` or `classList={{ "p-4": isOpen }}`,
+// whose class strings are found like the arguments of a class function. The
+// text of the elements and the rest of the code are left untouched, except
+// for the class functions it calls.
+func (sorter *Sorter) extractJSXClassStrings(content []byte) []classString {
+	return sorter.findJSXClassStrings(string(content), 0)
+}
+
+// findJSXClassStrings walks JavaScript code and returns the class strings of
+// the elements it contains and of the class functions it calls, offset by
+// offset.
+func (sorter *Sorter) findJSXClassStrings(code string, offset int) []classString {
+	var classStrings []classString
+
+	previous := ""
+	for idx := 0; idx < len(code); idx++ {
+		char := code[idx]
+		switch {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r':
+			continue
+		case strings.HasPrefix(code[idx:], "//"), strings.HasPrefix(code[idx:], "/*"):
+			idx = skipJSXSpace(code, idx) - 1
+			continue
+		case char == '\'' || char == '"' || char == '`':
+			end := skipJSString(code, idx)
+			if end == -1 {
+				return classStrings
+			}
+			previous = "literal"
+			idx = end - 1
+		case char == '<' && startsJSXElement(code[idx:], previous):
+			// Type arguments and comparisons that look like an element are
+			// not closed as one and are skipped as a single `<`.
+			elementClassStrings, end := sorter.scanJSXElement(code, idx, offset)
+			if end == -1 {
+				previous = "<"
+				continue
+			}
+			classStrings = append(classStrings, elementClassStrings...)
+			previous = "literal"
+			idx = end - 1
+		case isJSIdentifierChar(char):
+			start := idx
+			for idx+1 < len(code) && isJSIdentifierChar(code[idx+1]) {
+				idx++
+			}
+			previous = code[start : idx+1]
+
+			if !sorter.isClassFunction(previous) {
+				continue
+			}
+			callStart := skipJSXSpace(code, idx+1)
+			if callStart >= len(code) {
+				continue
+			}
+			end := -1
+			switch code[callStart] {
+			case '(':
+				end = skipJSBlock(code, callStart+1)
+			case '`':
+				end = skipJSString(code, callStart)
+			}
+			if end == -1 {
+				continue
+			}
+			classStrings = append(classStrings, sorter.findJSClassStrings(code[start:end], offset+start, jsCode)...)
+			previous = ")"
+			idx = end - 1
+		default:
+			previous = string(char)
+		}
+	}
+
+	return classStrings
+}
+
+// scanJSXElement reads the element starting at start, including its children,
+// and returns its class strings and the position right after it, or -1 when
+// it is not an element.
+func (sorter *Sorter) scanJSXElement(code string, start, offset int) ([]classString, int) {
+	var classStrings []classString
+
+	idx := start + 1
+	for idx < len(code) && isJSXNameChar(code[idx]) {
+		idx++
+	}
+	name := code[start+1 : idx]
+
+	for opened := false; !opened; {
+		idx = skipJSXSpace(code, idx)
+		if idx >= len(code) {
+			return nil, -1
+		}
+
+		switch {
+		case strings.HasPrefix(code[idx:], "/>"):
+			return classStrings, idx + 2
+		case code[idx] == '>':
+			opened = true
+			idx++
+		case code[idx] == '{':
+			// Spread attributes, such as `{...props}`.
+			end := skipJSBlock(code, idx+1)
+			if end == -1 {
+				return nil, -1
+			}
+			classStrings = append(classStrings, sorter.findJSXClassStrings(code[idx+1:end-1], offset+idx+1)...)
+			idx = end
+		case isJSXNameChar(code[idx]):
+			nameStart := idx
+			for idx < len(code) && isJSXNameChar(code[idx]) {
+				idx++
+			}
+			attribute := code[nameStart:idx]
+			isClassAttribute := sorter.classAttributeNameRegex.MatchString(attribute)
+
+			valueStart := skipJSXSpace(code, idx)
+			if valueStart >= len(code) || code[valueStart] != '=' {
+				continue
+			}
+			valueStart = skipJSXSpace(code, valueStart+1)
+			if valueStart >= len(code) {
+				return nil, -1
+			}
+
+			switch code[valueStart] {
+			case '"', '\'':
+				// JSX strings have no escape sequences.
+				length := strings.IndexByte(code[valueStart+1:], code[valueStart])
+				if length == -1 {
+					return nil, -1
+				}
+				if isClassAttribute {
+					classStrings = append(classStrings, classString{Start: offset + valueStart + 1, End: offset + valueStart + 1 + length})
+				}
+				idx = valueStart + length + 2
+			case '{':
+				end := skipJSBlock(code, valueStart+1)
+				if end == -1 {
+					return nil, -1
+				}
+				expression := code[valueStart+1 : end-1]
+				if isClassAttribute {
+					classStrings = append(classStrings, sorter.findJSClassStrings(expression, offset+valueStart+1, jsArguments)...)
+				} else {
+					classStrings = append(classStrings, sorter.findJSXClassStrings(expression, offset+valueStart+1)...)
+				}
+				idx = end
+			case '<':
+				elementClassStrings, end := sorter.scanJSXElement(code, valueStart, offset)
+				if end == -1 {
+					return nil, -1
+				}
+				classStrings = append(classStrings, elementClassStrings...)
+				idx = end
+			default:
+				return nil, -1
+			}
+		default:
+			return nil, -1
+		}
+	}
+
+	// The text of the children is not code, so only elements and expression
+	// containers are read until the closing tag.
+	for {
+		next := strings.IndexAny(code[idx:], "<{")
+		if next == -1 {
+			return nil, -1
+		}
+		idx += next
+
+		switch {
+		case code[idx] == '{':
+			end := skipJSBlock(code, idx+1)
+			if end == -1 {
+				return nil, -1
+			}
+			classStrings = append(classStrings, sorter.findJSXClassStrings(code[idx+1:end-1], offset+idx+1)...)
+			idx = end
+		case strings.HasPrefix(code[idx:], "</"):
+			end := strings.IndexByte(code[idx:], '>')
+			if end == -1 || strings.TrimSpace(code[idx+2:idx+end]) != name {
+				return nil, -1
+			}
+			return classStrings, idx + end + 1
+		default:
+			elementClassStrings, end := sorter.scanJSXElement(code, idx, offset)
+			if end == -1 {
+				return nil, -1
+			}
+			classStrings = append(classStrings, elementClassStrings...)
+			idx = end
+		}
+	}
+}
+
+// startsJSXElement tells whether the `<` the code starts with opens an element
+// or a fragment, given the token before it.
+func startsJSXElement(code, previous string) bool {
+	if len(code) < 2 {
+		return false
+	}
+
+	next := code[1]
+	if next != '>' && next != '_' && next != '$' && !(next >= 'a' && next <= 'z') && !(next >= 'A' && next <= 'Z') {
+		return false
+	}
+
+	switch {
+	case previous == "literal" || previous == ")" || previous == "]":
+		return false
+	case previous != "" && isJSIdentifierChar(previous[0]):
+		_, ok := jsxKeywords[previous]
+		return ok
+	}
+
+	return true
+}
+
+// skipJSXSpace returns the position of the first character from start that is
+// neither a space nor part of a comment.
+func skipJSXSpace(code string, start int) int {
+	idx := start
+	for idx < len(code) {
+		switch {
+		case code[idx] == ' ' || code[idx] == '\t' || code[idx] == '\n' || code[idx] == '\r':
+			idx++
+		case strings.HasPrefix(code[idx:], "//"):
+			end := strings.IndexByte(code[idx:], '\n')
+			if end == -1 {
+				return len(code)
+			}
+			idx += end + 1
+		case strings.HasPrefix(code[idx:], "/*"):
+			end := strings.Index(code[idx+2:], "*/")
+			if end == -1 {
+				return len(code)
+			}
+			idx += end + 4
+		default:
+			return idx
+		}
+	}
+
+	return idx
+}
+
+func isJSXNameChar(char byte) bool {
+	return isJSIdentifierChar(char) || char == '-' || char == ':'
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/selene466/go-tailwind-sorter/internal/config"
+)
+
+func TestSortJSXClassStrings(t *testing.T) {
+	sorter := newTestSorter(t, config.UserConfig{
+		ClassAttributes: []string{"className", "classList"},
+		ClassFunctions:  []string{"cn"},
+	}, nil)
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "string attribute",
+			content: `const a = <div className="p-4 flex" title="p-4 flex" />;`,
+			want:    `const a = <div className="flex p-4" title="p-4 flex" />;`,
+		},
+		{
+			name:    "string in expression container",
+			content: `const a = <div className={"p-4 flex"} />;`,
+			want:    `const a = <div className={"flex p-4"} />;`,
+		},
+		{
+			name:    "condition",
+			content: `const a = <div className={cond ? "p-4 flex" : "p-2 block"} />;`,
+			want:    `const a = <div className={cond ? "flex p-4" : "block p-2"} />;`,
+		},
+		{
+			name:    "compared literal",
+			content: `const a = <div className={size === "p-4 flex" ? "p-4 flex" : ""} />;`,
+			want:    `const a = <div className={size === "p-4 flex" ? "flex p-4" : ""} />;`,
+		},
+		{
+			name:    "template literal",
+			content: "const a = <div className={`p-4 flex ${open ? 'p-2 block' : ''}`} />;",
+			want:    "const a = <div className={`flex p-4 ${open ? 'block p-2' : ''}`} />;",
+		},
+		{
+			name:    "classList object",
+			content: `const a = <div classList={{ "p-4 flex": open, "p-2 block": !open }} />;`,
+			want:    `const a = <div classList={{ "flex p-4": open, "block p-2": !open }} />;`,
+		},
+		{
+			name:    "class function",
+			content: `const a = <div className={cn("p-4 flex", open && "p-2 block")} />;`,
+			want:    `const a = <div className={cn("flex p-4", open && "block p-2")} />;`,
+		},
+		{
+			name:    "children text",
+			content: `const a = <p className="p-4 flex">Don't "sort" className="p-4 flex"</p>;`,
+			want:    `const a = <p className="flex p-4">Don't "sort" className="p-4 flex"</p>;`,
+		},
+		{
+			name:    "nested elements",
+			content: `const a = <ul>{items.map((item) => <li key={item} className="p-4 flex">{item}</li>)}</ul>;`,
+			want:    `const a = <ul>{items.map((item) => <li key={item} className="flex p-4">{item}</li>)}</ul>;`,
+		},
+		{
+			name:    "comment containing a quote",
+			content: "// don't\nconst a = <div /* it's */ className=\"p-4 flex\" />;",
+			want:    "// don't\nconst a = <div /* it's */ className=\"flex p-4\" />;",
+		},
+		{
+			name:    "generic arrow function",
+			content: `const f = <T,>(x: T) => x; const a = <div className="p-4 flex" />;`,
+			want:    `const f = <T,>(x: T) => x; const a = <div className="flex p-4" />;`,
+		},
+		{
+			name:    "type arguments and comparisons",
+			content: `const [s] = useState<string>("p-4 flex"); if (a < b) {} const a = <div className="p-4 flex" />;`,
+			want:    `const [s] = useState<string>("p-4 flex"); if (a < b) {} const a = <div className="flex p-4" />;`,
+		},
+		{
+			name:    "code outside elements",
+			content: `const className = "p-4 flex";`,
+			want:    `const className = "p-4 flex";`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := sortFile(sorter, "component.tsx", test.content); got != test.want {
+				t.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"slices"
+	"strings"
+)
+
+// Tailwind emits the components layer before the utilities layer. Custom
+// groups and unknown classes are kept before or after both.
+const (
+	layerStart int = iota
+	layerComponents
+	layerUtilities
+	layerEnd
+)
+
+func buildPropertyIndex(propertyOrder []string) map[string]int {
+	propertyIndex := make(map[string]int, len(propertyOrder))
+	for idx, property := range propertyOrder {
+		if _, exists := propertyIndex[property]; !exists {
+			propertyIndex[property] = idx
+		}
+	}
+
+	return propertyIndex
+}
+
+// resolveUtility looks up the CSS properties generated by a utility and
+// returns their positions in the property order, sorted ascending.
+func (sorter *Sorter) resolveUtility(utility parsedUtility) ([]int, bool) {
+	// Arbitrary properties are ranked by the property they set. Custom
+	// properties such as `[--gutter:2rem]` have no rank and sort last.
+	if utility.Property != "" {
+		return sorter.propertySort([]string{utility.Property}), true
+	}
+
+	if properties, ok := sorter.Config.StaticUtilities[utility.Name]; ok {
+		return sorter.propertySort(properties), true
+	}
+
+	// Try the longest root first so that `rounded-tl-lg` resolves to
+	// `rounded-tl` rather than `rounded`. Dashes inside arbitrary values, as in
+	// `bg-(--brand)`, do not end a root.
+	roots := functionalRoots(utility.Name)
+	for idx := len(roots) - 1; idx >= 0; idx-- {
+		root := roots[idx]
+		properties, ok := sorter.Config.FunctionalUtilities[root]
+		if !ok {
+			continue
+		}
+
+		// Utilities such as `text-*` or `border-*` set a different property
+		// depending on the value they are given.
+		value := strings.TrimPrefix(utility.Name[len(root):], "-")
+		kindProperties, hasKind := sorter.Config.ValueUtilities[root][sorter.valueKind(value)]
+		if hasKind {
+			properties = kindProperties
+		} else if utility.Modifier != "" {
+			properties = slices.Concat(properties, sorter.Config.ModifierUtilities[root])
+		}
+
+		return sorter.propertySort(properties), true
+	}
+
+	return nil, false
+}
+
+// functionalRoots returns the parts of a utility ending before each of its
+// dashes and the whole utility, from the shortest to the longest, since bare
+// utilities such as `rounded` use the default value of their root.
+func functionalRoots(utility string) []string {
+	var roots []string
+
+	depth := 0
+	for idx := 1; idx < len(utility); idx++ {
+		switch utility[idx] {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		case '-':
+			if depth == 0 {
+				roots = append(roots, utility[:idx])
+			}
+		}
+	}
+
+	return append(roots, utility)
+}
+
+func (sorter *Sorter) propertySort(properties []string) []int {
+	sortedProperties := make([]int, 0, len(properties))
+	for _, property := range properties {
+		if idx, ok := sorter.propertyIndex[property]; ok {
+			sortedProperties = append(sortedProperties, idx)
+		}
+	}
+	slices.Sort(sortedProperties)
+
+	return sortedProperties
+}
+
+// compareVariants mirrors the bitmask comparison Tailwind uses: the highest
+// ranked variant decides, and a class with more variants sorts after one whose
+// variants are a subset of its own.
+func compareVariants(classI, classJ ClassProperty) int {
+	for idx := 0; idx < len(classI.Variants) && idx < len(classJ.Variants); idx++ {
+		if result := compareVariantProperties(classI.Variants[idx], classJ.Variants[idx]); result != 0 {
+			return result
+		}
+	}
+
+	return len(classI.Variants) - len(classJ.Variants)
+}
+
+// compareProperties follows Tailwind: the first differing property decides,
+// a class that runs out of properties sorts last, then classes generating more
+// properties come first and finally the names are compared.
+func compareProperties(classI, classJ ClassProperty) int {
+	offset := 0
+	for offset < len(classI.Properties) && offset < len(classJ.Properties) && classI.Properties[offset] == classJ.Properties[offset] {
+		offset++
+	}
+
+	switch {
+	case offset < len(classI.Properties) && offset < len(classJ.Properties):
+		return classI.Properties[offset] - classJ.Properties[offset]
+	case offset < len(classI.Properties):
+		return -1
+	case offset < len(classJ.Properties):
+		return 1
+	}
+
+	if result := compareNatural(classI.Utility, classJ.Utility); result != 0 {
+		return result
+	}
+
+	return strings.Compare(classI.OriginalName, classJ.OriginalName)
+}
+
+func compareClassProperties(classI, classJ ClassProperty) int {
+	// Classes kept at the start or the end do not mix with the Tailwind classes,
+	// whatever their variants or their place in the compiled stylesheet.
+	// Classes of the same group keep their order.
+	if isOuterLayer(classI.Layer) || isOuterLayer(classJ.Layer) {
+		if classI.Layer != classJ.Layer {
+			return classI.Layer - classJ.Layer
+		}
+		return classI.UtilityOrder - classJ.UtilityOrder
+	}
+
+	// Classes found in the compiled stylesheet keep its order and come before
+	// the ones that fall back to the built-in order.
+	if classI.CSSOrder != -1 || classJ.CSSOrder != -1 {
+		switch {
+		case classJ.CSSOrder == -1:
+			return -1
+		case classI.CSSOrder == -1:
+			return 1
+		}
+		return classI.CSSOrder - classJ.CSSOrder
+	}
+
+	if result := compareVariants(classI, classJ); result != 0 {
+		return result
+	}
+
+	if classI.Layer != classJ.Layer {
+		return classI.Layer - classJ.Layer
+	}
+
+	switch classI.Layer {
+	case layerComponents:
+		return classI.UtilityOrder - classJ.UtilityOrder
+	case layerUtilities:
+		return compareProperties(classI, classJ)
+	}
+
+	return 0
+}
+
+func isOuterLayer(layer int) bool {
+	return layer == layerStart || layer == layerEnd
+}
+
+// compareNatural compares two strings treating runs of digits as numbers, so
+// that `p-2` sorts before `p-10`.
+func compareNatural(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			startI, startJ := i, j
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+
+			numberI := strings.TrimLeft(a[startI:i], "0")
+			numberJ := strings.TrimLeft(b[startJ:j], "0")
+			if len(numberI) != len(numberJ) {
+				return len(numberI) - len(numberJ)
+			}
+			if result := strings.Compare(numberI, numberJ); result != 0 {
+				return result
+			}
+			continue
+		}
+
+		if a[i] != b[j] {
+			return int(a[i]) - int(b[j])
+		}
+		i++
+		j++
+	}
+
+	return (len(a) - i) - (len(b) - j)
+}
+
+func isDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"bytes"
 	"fmt"
 	"io/fs"
 	"os"
@@ -17,210 +18,374 @@ import (
 
 const numWorkers int = 4
 
-var (
-	arbitraryVariantRegex     *regexp.Regexp = regexp.MustCompile(`^\[.+?\]`)
-	templateLiteralSplitRegex *regexp.Regexp = regexp.MustCompile(`(?s)(\$\{.+\?\})`)
-)
-
 type Sorter struct {
 	Fix    bool
 	Config *config.Config
 
-	classAttributesRegex *regexp.Regexp
+	classAttributeNameRegex     *regexp.Regexp
+	htmlClassAttributeNameRegex *regexp.Regexp
+	classOrderIndex             *classOrderIndex
+	startGroupMatchers          []config.OrderMatcher
+	endGroupMatchers            []config.OrderMatcher
+	propertyIndex               map[string]int
 }
 
 func SorterServiceNew(config *config.Config, fix bool) (*Sorter, error) {
-	regexPattern := fmt.Sprintf(`((?:%s))(\s*=\s*)`+`(?:((["])(.*?)(["]))|((['])(.*?)([']))|(([`+"`"+`])(.*?)([`+"`"+`])))`, strings.Join(config.ClassAttributes, "|"))
+	classAttributePatterns, err := config.ClassAttributePatterns()
+	if err != nil {
+		return nil, fmt.Errorf("invalid classAttributes: %w", err)
+	}
 
-	classAttributesRegex, err := regexp.Compile(regexPattern)
+	namePattern := `(?:` + strings.Join(classAttributePatterns, `)|(?:`) + `)`
+	classAttributeNameRegex, err := regexp.Compile(`^(?:` + namePattern + `)$`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid classAttributes pattern: %w", err)
+	}
+	// HTML attribute names are case-insensitive.
+	htmlClassAttributeNameRegex, err := regexp.Compile(`(?i)^(?:` + namePattern + `)$`)
 	if err != nil {
 		return nil, fmt.Errorf("invalid classAttributes pattern: %w", err)
 	}
 
+	classOrderMatchers, err := config.ClassOrderMatchers()
+	if err != nil {
+		return nil, fmt.Errorf("invalid classOrder: %w", err)
+	}
+
+	startGroupMatchers, endGroupMatchers, err := config.CustomGroupMatchers()
+	if err != nil {
+		return nil, fmt.Errorf("invalid customGroups: %w", err)
+	}
+
 	return &Sorter{
 		Fix:    fix,
 		Config: config,
 
-		classAttributesRegex: classAttributesRegex,
+		classAttributeNameRegex:     classAttributeNameRegex,
+		htmlClassAttributeNameRegex: htmlClassAttributeNameRegex,
+		classOrderIndex:             newClassOrderIndex(classOrderMatchers),
+		startGroupMatchers:          startGroupMatchers,
+		endGroupMatchers:            endGroupMatchers,
+		propertyIndex:               buildPropertyIndex(config.PropertyOrder),
 	}, nil
 }
 
-type VariantProperty struct {
-	Order int
-	Name  string
-}
-
 type ClassProperty struct {
 	Variants     []VariantProperty
+	Layer        int
+	Properties   []int
 	UtilityOrder int
+	CSSOrder     int
+	Unknown      bool
+	Utility      string
 	OriginalName string
 }
 
 func (sorter *Sorter) getClassProperty(className string) ClassProperty {
-	parts := strings.Split(className, ":")
-	variants := make([]VariantProperty, 0) // Create an empty slice
+	variantNames, utility := splitVariants(className, sorter.Config.Separator)
+	variantNames, utility, prefixed := sorter.cutPrefix(variantNames, utility)
+	parsedUtility := parseUtility(utility)
+	utility = parsedUtility.Name
+
+	variants := make([]VariantProperty, 0, len(variantNames))
+	for _, variantName := range variantNames {
+		variants = append(variants, sorter.getVariantProperty(variantName))
+	}
 
-	utilityIndex := len(parts) - 1
-	for idx, part := range parts {
-		if arbitraryVariantRegex.MatchString(part) {
-			variants = append(variants, VariantProperty{Order: 99, Name: part})
-			continue
+	// Tailwind compares the variants of two classes starting from the highest
+	// ranked one, so keep them in descending order.
+	slices.SortFunc(variants, func(variantI, variantJ VariantProperty) int {
+		return compareVariantProperties(variantJ, variantI)
+	})
+
+	classProperty := ClassProperty{Variants: variants, CSSOrder: -1, Utility: utility, OriginalName: className}
+
+	if cssOrder, ok := sorter.Config.CSSOrder[className]; ok {
+		classProperty.CSSOrder = cssOrder
+	}
+
+	for idx, matcher := range sorter.startGroupMatchers {
+		if matcher.Match(utility) {
+			classProperty.Layer = layerStart
+			classProperty.UtilityOrder = idx
+			return classProperty
 		}
-		if order, ok := sorter.Config.VariantOrder[part]; ok {
-			variants = append(variants, VariantProperty{Order: order, Name: part})
-			continue
+	}
+	for idx, matcher := range sorter.endGroupMatchers {
+		if matcher.Match(utility) {
+			classProperty.Layer = layerEnd
+			classProperty.UtilityOrder = idx + 1
+			return classProperty
 		}
+	}
 
-		utilityIndex = idx
-		break
+	// Classes without the Tailwind prefix are not Tailwind classes.
+	if !prefixed {
+		return sorter.unknownClassProperty(classProperty)
 	}
-	utility := strings.Join(parts[utilityIndex:], ":")
 
-	sort.Slice(variants, func(i, j int) bool {
-		if variants[i].Order != variants[j].Order {
-			return variants[i].Order < variants[j].Order
+	// Exact class order entries name components that share their name with a
+	// utility root, such as daisyUI's `select` or `table`, so they take
+	// precedence over the utility.
+	if !sorter.classOrderIndex.isExact(utility) {
+		if properties, ok := sorter.resolveUtility(parsedUtility); ok {
+			classProperty.Layer = layerUtilities
+			classProperty.Properties = properties
+			return classProperty
 		}
-		return variants[i].Name < variants[j].Name
-	})
+	}
+
+	// Otherwise the class order is only a fallback for classes that are not
+	// Tailwind utilities, such as daisyUI component modifiers.
+	if order, ok := sorter.classOrderIndex.lookup(utility); ok {
+		classProperty.Layer = layerComponents
+		classProperty.UtilityOrder = order
+		return classProperty
+	}
 
-	utilityOrder := len(sorter.Config.ClassOrder)
-	for idx, prefix := range sorter.Config.ClassOrder {
-		if strings.HasPrefix(utility, prefix) {
-			utilityOrder = idx
-			break
+	return sorter.unknownClassProperty(classProperty)
+}
+
+// unknownClassProperty ranks a class that is neither a Tailwind class nor in
+// the class order. Classes found in the compiled stylesheet, such as custom
+// `@utility` classes, are ranked among the utilities by their place in it.
+// Unknown classes come after the custom groups placed at the start and before
+// the ones placed at the end.
+func (sorter *Sorter) unknownClassProperty(classProperty ClassProperty) ClassProperty {
+	if classProperty.CSSOrder != -1 {
+		classProperty.Layer = layerUtilities
+		return classProperty
+	}
+
+	classProperty.Unknown = true
+	classProperty.Layer = layerEnd
+	if sorter.Config.UnknownClasses == config.PositionStart {
+		classProperty.Layer = layerStart
+		classProperty.UtilityOrder = len(sorter.startGroupMatchers)
+	}
+
+	return classProperty
+}
+
+// cutPrefix removes the Tailwind prefix from a class and tells whether it had
+// one. Tailwind CSS v4 prefixes look like a variant (`tw:hover:flex`), v3
+// prefixes start the utility (`hover:tw-flex`, `-tw-mt-2`).
+func (sorter *Sorter) cutPrefix(variantNames []string, utility string) ([]string, string, bool) {
+	prefix := sorter.Config.Prefix
+	if prefix == "" {
+		return variantNames, utility, true
+	}
+
+	if variantPrefix, ok := strings.CutSuffix(prefix, ":"); ok {
+		if len(variantNames) == 0 || variantNames[0] != variantPrefix {
+			return variantNames, utility, false
 		}
+		return variantNames[1:], utility, true
 	}
 
-	return ClassProperty{Variants: variants, UtilityOrder: utilityOrder, OriginalName: className}
+	modifiers := utility[:len(utility)-len(strings.TrimLeft(utility, "!-"))]
+	unprefixed, ok := strings.CutPrefix(utility[len(modifiers):], prefix)
+
+	return variantNames, modifiers + unprefixed, ok
 }
 
+// tokenizeTWClassString splits a class string on whitespace. Whitespace
+// inside arbitrary values and `${...}` blocks does not split, so an
+// interpolation stays part of the class it touches, as in `bg-${color}-500`.
 func (sorter *Sorter) tokenizeTWClassString(twClassString string) []string {
 	var tokens []string
-	var currentToken strings.Builder
-
-	bracketLevel := 0
 
-	for _, char := range twClassString {
-		switch char {
-		case '[':
+	start, bracketLevel := -1, 0
+	for idx := 0; idx < len(twClassString); idx++ {
+		switch char := twClassString[idx]; {
+		case char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == '\f':
+			if bracketLevel == 0 && start != -1 {
+				tokens = append(tokens, twClassString[start:idx])
+				start = -1
+			}
+			continue
+		case char == '[':
 			bracketLevel++
-			currentToken.WriteRune(char)
-		case ']':
-			bracketLevel--
-			currentToken.WriteRune(char)
-		case ' ', '\t', '\n', '\r':
-			if bracketLevel == 0 {
-				if currentToken.Len() > 0 {
-					tokens = append(tokens, currentToken.String())
-					currentToken.Reset()
-				} else {
-					currentToken.WriteRune(char)
+		case char == ']':
+			bracketLevel = max(bracketLevel-1, 0)
+		case strings.HasPrefix(twClassString[idx:], "${"):
+			if end := skipJSBlock(twClassString, idx+2); end != -1 {
+				if start == -1 {
+					start = idx
 				}
+				idx = end - 1
+				continue
 			}
-		default:
-			currentToken.WriteRune(char)
+		}
+
+		if start == -1 {
+			start = idx
 		}
 	}
 
-	if currentToken.Len() > 0 {
-		tokens = append(tokens, currentToken.String())
+	if start != -1 {
+		tokens = append(tokens, twClassString[start:])
 	}
 
 	return tokens
 }
 
-func (sorter *Sorter) sortStaticTWClassString(staticTWClassString string) string {
-	fields := sorter.tokenizeTWClassString(staticTWClassString)
-	if len(fields) == 0 {
-		return ""
+// normalizeTWClassString rewrites the variant chain of every class in place,
+// keeping the whitespace and the dynamic parts of the string untouched.
+func (sorter *Sorter) normalizeTWClassString(twClassString string) string {
+	var result strings.Builder
+
+	cursor := 0
+	for _, twClass := range sorter.tokenizeTWClassString(twClassString) {
+		idx := strings.Index(twClassString[cursor:], twClass)
+		if idx == -1 {
+			continue
+		}
+
+		normalizedTWClass := twClass
+		if !strings.Contains(twClass, "${") {
+			normalizedTWClass = sorter.normalizeVariants(twClass)
+		}
+
+		result.WriteString(twClassString[cursor : cursor+idx])
+		result.WriteString(normalizedTWClass)
+		cursor += idx + len(twClass)
 	}
+	result.WriteString(twClassString[cursor:])
 
+	return result.String()
+}
+
+// sortTWClasses removes the duplicated classes and sorts the others.
+func (sorter *Sorter) sortTWClasses(twClasses []string) []string {
 	seenTWClass := make(map[string]struct{})
-	uniqueTWClasses := make([]string, 0, len(fields))
+	uniqueTWClasses := make([]string, 0, len(twClasses))
 
-	for _, twClass := range fields {
+	for _, twClass := range twClasses {
 		if _, exists := seenTWClass[twClass]; !exists {
 			seenTWClass[twClass] = struct{}{}
 			uniqueTWClasses = append(uniqueTWClasses, twClass)
 		}
 	}
 
-	sort.SliceStable(uniqueTWClasses, func(i, j int) bool {
-		classIProperty, classJProperty := sorter.getClassProperty(uniqueTWClasses[i]), sorter.getClassProperty(uniqueTWClasses[j])
-
-		if len(classIProperty.Variants) != len(classJProperty.Variants) {
-			return len(classIProperty.Variants) < len(classJProperty.Variants)
+	// Unknown classes may keep their position, the other classes are then
+	// sorted into the remaining slots. The sort key of each class is computed
+	// once rather than on every comparison.
+	slots := make([]int, 0, len(uniqueTWClasses))
+	classProperties := make([]ClassProperty, 0, len(uniqueTWClasses))
+	for idx, twClass := range uniqueTWClasses {
+		classProperty := sorter.getClassProperty(twClass)
+		if sorter.Config.UnknownClasses == config.PositionPreserve && classProperty.Unknown {
+			continue
 		}
+		slots = append(slots, idx)
+		classProperties = append(classProperties, classProperty)
+	}
 
-		for idx := 0; idx < len(classIProperty.Variants); idx++ {
-			if classIProperty.Variants[idx].Order != classJProperty.Variants[idx].Order {
-				return classIProperty.Variants[idx].Order < classJProperty.Variants[idx].Order
-			}
-		}
+	slices.SortStableFunc(classProperties, compareClassProperties)
 
-		return classIProperty.UtilityOrder < classJProperty.UtilityOrder
-	})
+	for idx, slot := range slots {
+		uniqueTWClasses[slot] = classProperties[idx].OriginalName
+	}
 
-	return strings.Join(uniqueTWClasses, " ")
+	return uniqueTWClasses
 }
 
-func (sorter *Sorter) sortTWClassString(twClassString string) string {
-	if strings.Contains(twClassString, "${") {
-		parts := templateLiteralSplitRegex.Split(twClassString, -1)
+// splitSeparators returns the whitespace around the tokens of a class string:
+// the leading whitespace, the whitespace between two tokens and the trailing
+// whitespace.
+func splitSeparators(twClassString string, tokens []string) []string {
+	separators := make([]string, 0, len(tokens)+1)
+
+	cursor := 0
+	for _, token := range tokens {
+		idx := strings.Index(twClassString[cursor:], token)
+		if idx == -1 {
+			idx = 0
+		}
+		separators = append(separators, twClassString[cursor:cursor+idx])
+		cursor += idx + len(token)
+	}
 
-		var result strings.Builder
+	return append(separators, twClassString[cursor:])
+}
 
-		for idx, part := range parts {
-			var processedPart string
+// joinWithSeparators puts the sorted classes back between the original
+// whitespace, so that line breaks and indentation are kept. The separators of
+// the duplicates that were removed are dropped.
+func joinWithSeparators(twClasses []string, separators []string) string {
+	var result strings.Builder
 
-			// Even-indexed parts are the static text between the dynamic blocks.
-			// Odd-indexed parts are the dynamic blocks themselves.
-			if idx%2 == 0 {
-				processedPart = sorter.sortStaticTWClassString(part)
-			} else {
-				processedPart = part
-			}
+	result.WriteString(separators[0])
+	for idx, twClass := range twClasses {
+		if idx > 0 {
+			result.WriteString(separators[idx])
+		}
+		result.WriteString(twClass)
+	}
+	result.WriteString(separators[len(separators)-1])
 
-			if processedPart != "" {
-				if result.Len() > 0 {
-					result.WriteString(" ")
-				}
-				result.WriteString(processedPart)
-			}
+	return result.String()
+}
+
+// sortTWClassString sorts the classes of a class string. Classes touching a
+// `${...}` block, such as `${size}` or `bg-${color}-500`, are anchors: they
+// keep their place and the classes between two anchors are sorted on their
+// own. The class strings inside the blocks are sorted too.
+func (sorter *Sorter) sortTWClassString(twClassString string) string {
+	fields := sorter.tokenizeTWClassString(twClassString)
+	if len(fields) == 0 {
+		if sorter.Config.PreserveWhitespace {
+			return twClassString
 		}
+		return ""
+	}
 
-		return result.String()
+	twClasses := make([]string, 0, len(fields))
+	start := 0
+	for idx, field := range fields {
+		if !strings.Contains(field, "${") {
+			continue
+		}
+		twClasses = append(twClasses, sorter.sortTWClasses(fields[start:idx])...)
+		twClasses = append(twClasses, sorter.sortInterpolations(field))
+		start = idx + 1
+	}
+	twClasses = append(twClasses, sorter.sortTWClasses(fields[start:])...)
 
-	} else {
-		return sorter.sortStaticTWClassString(twClassString)
+	if sorter.Config.PreserveWhitespace {
+		return joinWithSeparators(twClasses, splitSeparators(twClassString, fields))
 	}
+
+	return strings.Join(twClasses, " ")
 }
 
-func (sorter *Sorter) processFileContent(content []byte) []byte {
-	return sorter.classAttributesRegex.ReplaceAllFunc(content, func(match []byte) []byte {
-		parts := sorter.classAttributesRegex.FindSubmatch(match)
-
-		var openingQuote, twClassString, closingQuote []byte
-
-		// Based on which type of content group matches get the tw_class string.
-		if parts[5] != nil { // " " content group
-			openingQuote = parts[4]
-			twClassString = parts[5]
-			closingQuote = parts[6]
-		} else if parts[9] != nil { // ' ' content group
-			openingQuote = parts[8]
-			twClassString = parts[9]
-			closingQuote = parts[10]
-		} else if len(parts) > 13 && parts[13] != nil { // ` ` content group
-			openingQuote = parts[12]
-			twClassString = parts[13]
-			closingQuote = parts[14]
-		} else {
-			return match
+func (sorter *Sorter) processFileContent(content []byte, classStrings []classString) []byte {
+	var result bytes.Buffer
+
+	cursor := 0
+	for _, classString := range classStrings {
+		twClassString := string(content[classString.Start:classString.End])
+
+		// Class strings that are already sorted keep their layout.
+		sortedTWClassString := sorter.sortTWClassString(sorter.normalizeTWClassString(twClassString))
+		if sameClasses(twClassString, sortedTWClassString) {
+			sortedTWClassString = twClassString
 		}
 
-		return fmt.Appendf(nil, `%s%s%s%s%s`, parts[1], parts[2], openingQuote, sorter.sortTWClassString(string(twClassString)), closingQuote)
-	})
+		result.Write(content[cursor:classString.Start])
+		result.WriteString(sortedTWClassString)
+		cursor = classString.End
+	}
+	result.Write(content[cursor:])
+
+	return result.Bytes()
+}
+
+// sameClasses tells whether two class strings hold the same classes in the
+// same order, whatever the whitespace between them.
+func sameClasses(twClassString, otherTWClassString string) bool {
+	return slices.Equal(strings.Fields(twClassString), strings.Fields(otherTWClassString))
 }
 
 func (sorter *Sorter) fileHasValidExtension(filePath string) bool {
@@ -275,32 +440,38 @@ type Violation struct {
 	EndOffset   int
 	Rule        string
 	Msg         string
+	Help        string
 	Fixable     bool
 }
 
-func (sorter *Sorter) findViolations(content []byte) []Violation {
+func (sorter *Sorter) findViolations(content []byte, classStrings []classString) []Violation {
 	var violations []Violation
 
-	matches := sorter.classAttributesRegex.FindAllSubmatchIndex(content, -1)
-	for _, match := range matches {
-		var startOffset, endOffset int
-
-		// Based on which type of content group matches get the startOffset and endOffset of the tw_class string.
-		if match[10] != -1 { // " " content group matched
-			startOffset, endOffset = match[10], match[11]
-		} else if match[18] != -1 { // ' ' content group matched
-			startOffset, endOffset = match[18], match[19]
-		} else if match[26] != -1 { // ` ` content group matched
-			startOffset, endOffset = match[26], match[27]
-		} else {
-			continue
-		}
+	for _, classString := range classStrings {
+		startOffset, endOffset := classString.Start, classString.End
 
 		twClassString := string(content[startOffset:endOffset])
-		sortedTWClassString := sorter.sortTWClassString(twClassString)
+		line, col := utils.OffsetToLineCol(content, startOffset)
+
+		// Variant chains are normalized first so that both rules can be told
+		// apart: TWS001 only reports the order of the normalized classes.
+		normalizedTWClassString := sorter.normalizeTWClassString(twClassString)
+		if twClassString != normalizedTWClassString {
+			violations = append(violations, Violation{
+				Line:        line,
+				Col:         col,
+				StartOffset: startOffset,
+				EndOffset:   endOffset,
+				Rule:        "TWS002",
+				Msg:         "Unsorted Tailwind variants",
+				Help:        "Sort the variants of each class in the attribute",
+				Fixable:     true,
+			})
+		}
 
-		if twClassString != sortedTWClassString {
-			line, col := utils.OffsetToLineCol(content, startOffset)
+		// Only the order of the classes is reported, not the whitespace
+		// between them, such as the line breaks of a multi-line attribute.
+		if !sameClasses(normalizedTWClassString, sorter.sortTWClassString(normalizedTWClassString)) {
 			violations = append(violations, Violation{
 				Line:        line,
 				Col:         col,
@@ -308,6 +479,7 @@ func (sorter *Sorter) findViolations(content []byte) []Violation {
 				EndOffset:   endOffset,
 				Rule:        "TWS001",
 				Msg:         "Unsorted Tailwind classes",
+				Help:        "Sort the Tailwind CSS classes in the attribute",
 				Fixable:     true,
 			})
 		}
@@ -335,12 +507,14 @@ func (sorter *Sorter) worker(wg *sync.WaitGroup, jobs <-chan string, results cha
 			continue
 		}
 
-		violations := sorter.findViolations(originalContent)
+		classStrings := sorter.extractClassStrings(filePath, originalContent)
+
+		violations := sorter.findViolations(originalContent, classStrings)
 		if len(violations) == 0 {
 			continue
 		}
 
-		sortedContent := sorter.processFileContent(originalContent)
+		sortedContent := sorter.processFileContent(originalContent, classStrings)
 		results <- FileResult{
 			FilePath:      filePath,
 			Violations:    violations,
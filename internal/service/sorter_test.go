@@ -0,0 +1,305 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/selene466/go-tailwind-sorter/internal/config"
+)
+
+// benchmarkTWClassString mixes Tailwind utilities, variants, daisyUI
+// components and unknown classes, like a busy component does.
+const benchmarkTWClassString string = "btn btn-primary md:hover:bg-blue-600 flex items-center justify-between p-4 " +
+	"text-sm font-semibold card card-body lg:grid-cols-3 grid gap-4 rounded-lg shadow-md dark:bg-gray-800 " +
+	"focus:outline-none badge badge-lg transition-colors duration-200 hover:text-white sm:px-6 js-toggle w-full"
+
+// benchmarkClassNames are looked up in the class order: components, family
+// members and classes that match no entry at all.
+var benchmarkClassNames []string = []string{"btn", "btn-primary", "card-body", "modal-box", "badge-lg", "js-toggle", "unknown-class"}
+
+// newTestSorter writes the user config and the files it refers to, such as a
+// CSS entry, to a temporary directory and loads them the way the command
+// does, so that they are merged and validated like a real config file.
+func newTestSorter(tb testing.TB, userConfig config.UserConfig, files map[string]string) *Sorter {
+	tb.Helper()
+
+	dir := tb.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			tb.Fatal(err)
+		}
+	}
+
+	configFile, err := os.Create(filepath.Join(dir, config.DefaultConfigFileName))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer configFile.Close()
+
+	tomlRoot := config.TomlRoot{Tool: config.ToolSelection{Sorter: userConfig}}
+	if err := toml.NewEncoder(configFile).Encode(tomlRoot); err != nil {
+		tb.Fatal(err)
+	}
+
+	cfg, err := config.New(configFile.Name(), "")
+	if err != nil {
+		tb.Fatalf("config.New: %v", err)
+	}
+
+	sorter, err := SorterServiceNew(cfg, false)
+	if err != nil {
+		tb.Fatalf("SorterServiceNew: %v", err)
+	}
+
+	return sorter
+}
+
+// linearLookup is the scan the class order index replaces.
+func linearLookup(matchers []config.OrderMatcher, className string) (int, bool) {
+	for idx, matcher := range matchers {
+		if matcher.Match(className) {
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
+func TestClassOrderIndexMatchesLinearScan(t *testing.T) {
+	sorter := newTestSorter(t, config.UserConfig{}, nil)
+	matchers, err := sorter.Config.ClassOrderMatchers()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, className := range append(benchmarkClassNames, "btn-", "float-left", "x") {
+		wantOrder, wantFound := linearLookup(matchers, className)
+		gotOrder, gotFound := sorter.classOrderIndex.lookup(className)
+		if gotOrder != wantOrder || gotFound != wantFound {
+			t.Errorf("lookup(%q) = %d, %t, want %d, %t", className, gotOrder, gotFound, wantOrder, wantFound)
+		}
+	}
+}
+
+func BenchmarkSortTWClassString(b *testing.B) {
+	sorter := newTestSorter(b, config.UserConfig{}, nil)
+
+	for b.Loop() {
+		sorter.sortTWClassString(benchmarkTWClassString)
+	}
+}
+
+func BenchmarkClassOrderLookup(b *testing.B) {
+	sorter := newTestSorter(b, config.UserConfig{}, nil)
+
+	for b.Loop() {
+		for _, className := range benchmarkClassNames {
+			sorter.classOrderIndex.lookup(className)
+		}
+	}
+}
+
+func BenchmarkClassOrderLinearScan(b *testing.B) {
+	sorter := newTestSorter(b, config.UserConfig{}, nil)
+	matchers, err := sorter.Config.ClassOrderMatchers()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for b.Loop() {
+		for _, className := range benchmarkClassNames {
+			linearLookup(matchers, className)
+		}
+	}
+}
+
+func TestSortTWClassStringUserConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		userConfig config.UserConfig
+		classes    string
+		want       string
+	}{
+		{
+			name:       "defaults",
+			userConfig: config.UserConfig{},
+			classes:    "js-toggle p-4 flex",
+			want:       "flex p-4 js-toggle",
+		},
+		{
+			name: "custom group at the start",
+			userConfig: config.UserConfig{
+				CustomGroups: []config.CustomGroup{{Name: "js", Pattern: "^js-", Position: "start"}},
+			},
+			classes: "p-4 flex js-toggle",
+			want:    "js-toggle flex p-4",
+		},
+		{
+			name:       "unknown classes at the start",
+			userConfig: config.UserConfig{UnknownClasses: "start"},
+			classes:    "p-4 flex js-toggle",
+			want:       "js-toggle flex p-4",
+		},
+		{
+			name:       "prepended class order",
+			userConfig: config.UserConfig{ClassOrderPrepend: []string{"js-toggle"}},
+			classes:    "btn js-toggle",
+			want:       "js-toggle btn",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sorter := newTestSorter(t, test.userConfig, nil)
+			if got := sorter.sortTWClassString(test.classes); got != test.want {
+				t.Errorf("sortTWClassString(%q) = %q, want %q", test.classes, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSortDaisyUIComponentsNamedLikeUtilities(t *testing.T) {
+	sorter := newTestSorter(t, config.UserConfig{}, nil)
+	for _, component := range []string{"select", "list", "mask", "table", "collapse", "filter", "text-rotate"} {
+		classes := "mt-2 flex " + component
+		want := component + " mt-2 flex"
+		if got := sorter.sortTWClassString(classes); got != want {
+			t.Errorf("sortTWClassString(%q) = %q, want %q", classes, got, want)
+		}
+	}
+
+	// Without daisyUI they are utilities again.
+	sorter = newTestSorter(t, config.UserConfig{Presets: []string{"tailwind-v4"}}, nil)
+	if got, want := sorter.sortTWClassString("table mt-2"), "mt-2 table"; got != want {
+		t.Errorf("sortTWClassString(%q) = %q, want %q", "table mt-2", got, want)
+	}
+}
+
+func TestSortTWClassStringOrderFromCSS(t *testing.T) {
+	files := map[string]string{
+		"built.css": ".acme-card { padding: 1rem; }\n.flex { display: flex; }\n.p-4 { padding: 1rem; }\n",
+	}
+	tests := []struct {
+		name       string
+		userConfig config.UserConfig
+		classes    string
+		want       string
+	}{
+		{
+			name:       "custom class found in the stylesheet",
+			userConfig: config.UserConfig{OrderFromCSS: "built.css"},
+			classes:    "p-4 flex acme-card",
+			want:       "acme-card flex p-4",
+		},
+		{
+			name:       "classes missing from the stylesheet",
+			userConfig: config.UserConfig{OrderFromCSS: "built.css"},
+			classes:    "js-toggle mt-2 p-4 acme-card",
+			want:       "acme-card p-4 mt-2 js-toggle",
+		},
+		{
+			name:       "unknown classes at the start",
+			userConfig: config.UserConfig{OrderFromCSS: "built.css", UnknownClasses: "start"},
+			classes:    "p-4 js-toggle acme-card",
+			want:       "js-toggle acme-card p-4",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sorter := newTestSorter(t, test.userConfig, files)
+			if got := sorter.sortTWClassString(test.classes); got != test.want {
+				t.Errorf("sortTWClassString(%q) = %q, want %q", test.classes, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeCustomVariants(t *testing.T) {
+	sorter := newTestSorter(t, config.UserConfig{
+		CSSEntry:     "app.css",
+		VariantOrder: map[string]config.VariantOrderEntry{"hocus": {After: "hover"}},
+	}, map[string]string{
+		"app.css": "@import \"tailwindcss\";\n@custom-variant child (& > *);\n",
+	})
+
+	tests := []struct {
+		className string
+		want      string
+	}{
+		{className: "hover:md:flex", want: "md:hover:flex"},
+		{className: "hover:child:flex", want: "hover:child:flex"},
+		{className: "child:hover:flex", want: "child:hover:flex"},
+		{className: "hocus:md:flex", want: "hocus:md:flex"},
+		{className: "hover:child:focus:md:flex", want: "hover:child:md:focus:flex"},
+	}
+
+	for _, test := range tests {
+		if got := sorter.normalizeVariants(test.className); got != test.want {
+			t.Errorf("normalizeVariants(%q) = %q, want %q", test.className, got, test.want)
+		}
+	}
+}
+
+func TestSortMultiLineClassStrings(t *testing.T) {
+	tests := []struct {
+		name       string
+		userConfig config.UserConfig
+		filePath   string
+		content    string
+		want       string
+	}{
+		{
+			name:     "joined with single spaces",
+			filePath: "index.html",
+			content:  "<div class=\"\n  p-4 flex\n  text-sm block\n\"></div>",
+			want:     "<div class=\"block flex p-4 text-sm\"></div>",
+		},
+		{
+			name:       "layout kept",
+			userConfig: config.UserConfig{PreserveWhitespace: true},
+			filePath:   "index.html",
+			content:    "<div class=\"\n  p-4 mt-2\n  text-sm flex\n\"></div>",
+			want:       "<div class=\"\n  mt-2 flex\n  p-4 text-sm\n\"></div>",
+		},
+		{
+			name:       "layout kept with CRLF and tabs",
+			userConfig: config.UserConfig{PreserveWhitespace: true},
+			filePath:   "index.html",
+			content:    "<div class=\"p-4\r\n\t\tflex\"></div>",
+			want:       "<div class=\"flex\r\n\t\tp-4\"></div>",
+		},
+		{
+			name:     "sorted attribute keeps its layout",
+			filePath: "index.html",
+			content:  "<div class=\"\n  flex\n  p-4\n\"></div>",
+			want:     "<div class=\"\n  flex\n  p-4\n\"></div>",
+		},
+		{
+			name:     "scanned file",
+			filePath: "page.php",
+			content:  "<div class='\n  p-4\n  flex'></div>",
+			want:     "<div class='flex p-4'></div>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			userConfig := test.userConfig
+			userConfig.FilePatterns = []string{".html", ".php"}
+			sorter := newTestSorter(t, userConfig, nil)
+
+			if got := sortFile(sorter, test.filePath, test.content); got != test.want {
+				t.Errorf("got\n%q\nwant\n%q", got, test.want)
+			}
+
+			// Only the order of the classes is reported.
+			sorted := []byte(test.want)
+			if violations := sorter.findViolations(sorted, sorter.extractClassStrings(test.filePath, sorted)); len(violations) != 0 {
+				t.Errorf("sorted content has violations: %+v", violations)
+			}
+		})
+	}
+}
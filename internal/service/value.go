@@ -0,0 +1,166 @@
+package service
+
+import (
+	"strings"
+)
+
+// colorKeywords are the color values every color utility accepts.
+var colorKeywords map[string]struct{} = map[string]struct{}{
+	"inherit": {}, "current": {}, "transparent": {}, "black": {}, "white": {},
+}
+
+// colorPalettes are the default Tailwind palettes, used as `red-500`, and the
+// daisyUI semantic colors, used as `primary` or `base-200`.
+var colorPalettes map[string]struct{} = map[string]struct{}{
+	"slate": {}, "gray": {}, "zinc": {}, "neutral": {}, "stone": {}, "red": {}, "orange": {}, "amber": {}, "yellow": {},
+	"lime": {}, "green": {}, "emerald": {}, "teal": {}, "cyan": {}, "sky": {}, "blue": {}, "indigo": {}, "violet": {},
+	"purple": {}, "fuchsia": {}, "pink": {}, "rose": {},
+
+	"primary": {}, "primary-content": {}, "secondary": {}, "secondary-content": {}, "accent": {}, "accent-content": {},
+	"neutral-content": {}, "base": {}, "base-content": {}, "info": {}, "info-content": {}, "success": {},
+	"success-content": {}, "warning": {}, "warning-content": {}, "error": {}, "error-content": {},
+}
+
+// typeHints maps the data types Tailwind accepts in arbitrary values, as in
+// `text-[length:var(--size)]`, to the kinds of value used by ValueUtilities.
+var typeHints map[string]string = map[string]string{
+	"color": "color", "length": "length", "line-width": "length", "absolute-size": "length", "relative-size": "length",
+	"number": "number", "integer": "number", "percentage": "percentage", "url": "image", "image": "image",
+	"position": "position", "bg-position": "position", "size": "size", "bg-size": "size", "family-name": "family",
+	"generic-name": "family",
+}
+
+// valueKind tells what kind of value a functional utility was given. Values
+// whose kind cannot be told, such as `lg` or `mono`, return an empty string.
+func (sorter *Sorter) valueKind(value string) string {
+	value, _ = splitModifier(value)
+
+	if arbitrary, ok := strings.CutPrefix(value, "["); ok {
+		return arbitraryValueKind(strings.TrimSuffix(arbitrary, "]"))
+	}
+	if variable, ok := strings.CutPrefix(value, "("); ok {
+		if hint, _, found := strings.Cut(strings.TrimSuffix(variable, ")"), ":"); found {
+			return typeHints[hint]
+		}
+		return ""
+	}
+
+	if sorter.isColor(value) {
+		return "color"
+	}
+	if isNumber(value) {
+		return "number"
+	}
+
+	return ""
+}
+
+func (sorter *Sorter) isColor(value string) bool {
+	if _, ok := colorKeywords[value]; ok {
+		return true
+	}
+	if _, ok := sorter.Config.Theme.Colors[value]; ok {
+		return true
+	}
+	if _, ok := colorPalettes[value]; ok {
+		return true
+	}
+
+	palette, shade, found := cutLast(value, "-")
+	if !found || !isNumber(shade) {
+		return false
+	}
+	if _, ok := sorter.Config.Theme.Colors[palette]; ok {
+		return true
+	}
+	_, ok := colorPalettes[palette]
+
+	return ok
+}
+
+// arbitraryValueKind reads the type hint of an arbitrary value, or guesses the
+// kind from the value itself when there is none.
+func arbitraryValueKind(value string) string {
+	if hint, _, found := strings.Cut(value, ":"); found {
+		if kind, ok := typeHints[hint]; ok {
+			return kind
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(value, "#"),
+		strings.HasPrefix(value, "rgb"),
+		strings.HasPrefix(value, "hsl"),
+		strings.HasPrefix(value, "hwb"),
+		strings.HasPrefix(value, "lab("),
+		strings.HasPrefix(value, "lch("),
+		strings.HasPrefix(value, "oklab("),
+		strings.HasPrefix(value, "oklch("),
+		strings.HasPrefix(value, "color("),
+		strings.HasPrefix(value, "color-mix("),
+		strings.HasPrefix(value, "var(--color-"):
+		return "color"
+	case strings.HasPrefix(value, "url("),
+		strings.Contains(value, "gradient("),
+		strings.HasPrefix(value, "image-set("):
+		return "image"
+	case strings.HasSuffix(value, "%") && isNumber(strings.TrimSuffix(value, "%")):
+		return "percentage"
+	case isNumber(value):
+		return "number"
+	case isLength(value):
+		return "length"
+	}
+
+	return ""
+}
+
+func isLength(value string) bool {
+	if strings.HasPrefix(value, "calc(") || strings.HasPrefix(value, "clamp(") {
+		return true
+	}
+
+	idx := 0
+	for idx < len(value) && (isDigit(value[idx]) || value[idx] == '.' || value[idx] == '-') {
+		idx++
+	}
+	if idx == 0 || !isNumber(value[:idx]) {
+		return false
+	}
+
+	unit := value[idx:]
+	for _, char := range unit {
+		if char < 'a' || char > 'z' {
+			return false
+		}
+	}
+
+	return unit != ""
+}
+
+func isNumber(value string) bool {
+	value = strings.TrimPrefix(value, "-")
+
+	digits, dots := 0, 0
+	for idx := 0; idx < len(value); idx++ {
+		switch {
+		case isDigit(value[idx]):
+			digits++
+		case value[idx] == '.':
+			dots++
+		default:
+			return false
+		}
+	}
+
+	return digits > 0 && dots <= 1
+}
+
+func cutLast(value, separator string) (string, string, bool) {
+	idx := strings.LastIndex(value, separator)
+	if idx == -1 {
+		return value, "", false
+	}
+
+	return value[:idx], value[idx+len(separator):], true
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/selene466/go-tailwind-sorter/internal/config"
+)
+
+const (
+	// Variants that are neither known nor arbitrary are ranked after every
+	// known variant but before arbitrary ones.
+	unknownVariantOrder int = math.MaxInt32 - 1
+	// Arbitrary variants are ranked after every known variant, including
+	// custom ones read from the configuration.
+	arbitraryVariantOrder int = math.MaxInt32
+)
+
+// compoundVariants wrap another variant, e.g. `group-hover` or `not-first`.
+var compoundVariants map[string]struct{} = map[string]struct{}{
+	"not": {}, "group": {}, "peer": {}, "in": {}, "has": {},
+}
+
+// orderedVariants change the element a class applies to, so moving another
+// variant across them changes what the class means, e.g. `*:hover:flex` and
+// `hover:*:flex`.
+var orderedVariants map[string]struct{} = map[string]struct{}{
+	"*": {}, "**": {}, "first-letter": {}, "first-line": {}, "marker": {}, "selection": {}, "file": {},
+	"placeholder": {}, "backdrop": {}, "details-content": {}, "before": {}, "after": {},
+}
+
+type VariantProperty struct {
+	Order    int
+	Compound *VariantProperty
+	Value    string
+	Name     string
+	// Screen variants, such as `md`, `min-[900px]` or `max-lg`, and container
+	// queries, such as `@md` or `@max-lg`, are ranked by their width, which is
+	// negated for max-width variants so that the widest comes first.
+	Screen bool
+	Width  float64
+}
+
+// splitVariants splits a class into its variants and its utility. Separators
+// nested in brackets or parentheses, as in `supports-[display:grid]:grid` or
+// `[&:hover]:flex`, do not split.
+func splitVariants(className, separator string) ([]string, string) {
+	var variants []string
+
+	start, depth := 0, 0
+	for idx := 0; idx < len(className); idx++ {
+		switch className[idx] {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		default:
+			if depth == 0 && strings.HasPrefix(className[idx:], separator) {
+				variants = append(variants, className[start:idx])
+				idx += len(separator) - 1
+				start = idx + 1
+			}
+		}
+	}
+
+	return variants, className[start:]
+}
+
+// splitModifier splits the modifier off a variant, e.g. `group-hover/item`.
+func splitModifier(variant string) (string, string) {
+	depth := 0
+	for idx := len(variant) - 1; idx >= 0; idx-- {
+		switch variant[idx] {
+		case ']', ')':
+			depth++
+		case '[', '(':
+			depth--
+		case '/':
+			if depth == 0 {
+				return variant[:idx], variant[idx+1:]
+			}
+		}
+	}
+
+	return variant, ""
+}
+
+func (sorter *Sorter) getVariantProperty(variant string) VariantProperty {
+	variantProperty := VariantProperty{Order: unknownVariantOrder, Name: variant}
+
+	name, _ := splitModifier(variant)
+	if strings.HasPrefix(name, "[") {
+		variantProperty.Order = arbitraryVariantOrder
+		return variantProperty
+	}
+
+	// Breakpoints are min-width variants and are ranked together with `min-*`.
+	if _, ok := sorter.Config.Theme.Breakpoints[name]; ok {
+		if width, ok := sorter.Config.ScreenWidth(name); ok {
+			variantProperty.Order = sorter.Config.VariantOrder["min-*"]
+			variantProperty.Screen, variantProperty.Width = true, width
+			return variantProperty
+		}
+	}
+
+	if order, ok := sorter.Config.VariantOrder[name]; ok {
+		variantProperty.Order = order
+		return variantProperty
+	}
+
+	root, value, ok := sorter.splitVariantRoot(name)
+	if !ok {
+		return variantProperty
+	}
+
+	variantProperty.Order = sorter.Config.VariantOrder[root]
+	switch root {
+	case "min-*", "max-*":
+		if width, ok := sorter.Config.ScreenWidth(value); ok {
+			variantProperty.Screen, variantProperty.Width = true, width
+			if root == "max-*" {
+				variantProperty.Width = -width
+			}
+		}
+	case "@*", "@min-*", "@max-*":
+		// `@md` and `@min-md` are the same min-width query and Tailwind ranks
+		// them together. Named containers, as in `@lg/sidebar`, are ranked by
+		// their size too.
+		if order, ok := sorter.Config.VariantOrder["@*"]; ok && root == "@min-*" {
+			variantProperty.Order = order
+		}
+		if width, ok := sorter.Config.ContainerWidth(value); ok {
+			variantProperty.Screen, variantProperty.Width = true, width
+			if root == "@max-*" {
+				variantProperty.Width = -width
+			}
+		}
+	}
+
+	if _, isCompound := compoundVariants[strings.TrimSuffix(root, "-*")]; isCompound {
+		compound := sorter.getVariantProperty(value)
+		variantProperty.Compound = &compound
+	} else {
+		variantProperty.Value = value
+	}
+
+	return variantProperty
+}
+
+// splitVariantRoot finds the longest functional or compound variant the name
+// starts with, e.g. `nth-last-of-type-*` for `nth-last-of-type-3` and `@max-*`
+// for `@max-md`, and returns it together with the value.
+func (sorter *Sorter) splitVariantRoot(name string) (string, string, bool) {
+	if containerQuery, found := strings.CutPrefix(name, "@"); found {
+		for _, root := range []string{"max", "min"} {
+			if value, found := strings.CutPrefix(containerQuery, root+"-"); found {
+				if _, ok := sorter.Config.VariantOrder["@"+root+"-*"]; ok {
+					return "@" + root + "-*", value, true
+				}
+			}
+		}
+
+		if _, ok := sorter.Config.VariantOrder["@*"]; ok {
+			return "@*", containerQuery, true
+		}
+		return "", "", false
+	}
+
+	root, value, found := "", "", false
+
+	depth := 0
+	for idx := 0; idx < len(name); idx++ {
+		switch name[idx] {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		case '-':
+			if depth != 0 || idx == len(name)-1 {
+				continue
+			}
+			if _, ok := sorter.Config.VariantOrder[name[:idx]+"-*"]; ok {
+				root, value, found = name[:idx]+"-*", name[idx+1:], true
+			}
+		}
+	}
+
+	return root, value, found
+}
+
+// compareVariantProperties orders two variants by rank. Variants sharing a
+// rank are ordered by the variant they wrap or by their value.
+func compareVariantProperties(variantI, variantJ VariantProperty) int {
+	if variantI.Order != variantJ.Order {
+		return cmp.Compare(variantI.Order, variantJ.Order)
+	}
+
+	if variantI.Screen && variantJ.Screen && variantI.Width != variantJ.Width {
+		return cmp.Compare(variantI.Width, variantJ.Width)
+	}
+
+	switch {
+	case variantI.Compound != nil && variantJ.Compound != nil:
+		if result := compareVariantProperties(*variantI.Compound, *variantJ.Compound); result != 0 {
+			return result
+		}
+	case variantI.Compound != nil:
+		return 1
+	case variantJ.Compound != nil:
+		return -1
+	}
+
+	if result := compareNatural(variantI.Value, variantJ.Value); result != 0 {
+		return result
+	}
+
+	return strings.Compare(variantI.Name, variantJ.Name)
+}
+
+// normalizeVariants rewrites the variants of a class in their canonical order:
+// the variant with the highest rank, such as a media query, is applied first.
+// That is the leftmost variant with Tailwind CSS v4 semantics and the
+// rightmost with v3 semantics. Variants only move between the variants that
+// change the element the class applies to, so the class keeps its meaning.
+func (sorter *Sorter) normalizeVariants(className string) string {
+	variantNames, utility := splitVariants(className, sorter.Config.Separator)
+	if len(variantNames) < 2 {
+		return className
+	}
+
+	normalizedVariants := slices.Clone(variantNames)
+
+	start := 0
+	// A Tailwind CSS v4 prefix always comes first.
+	if variantPrefix, ok := strings.CutSuffix(sorter.Config.Prefix, ":"); ok && normalizedVariants[0] == variantPrefix {
+		start = 1
+	}
+
+	for start < len(normalizedVariants) {
+		end := start
+		for end < len(normalizedVariants) && !sorter.isOrderedVariant(normalizedVariants[end]) {
+			end++
+		}
+
+		segment := normalizedVariants[start:end]
+		slices.SortStableFunc(segment, func(variantI, variantJ string) int {
+			result := compareVariantProperties(sorter.getVariantProperty(variantJ), sorter.getVariantProperty(variantI))
+			if sorter.Config.VariantSemantics == config.VariantSemanticsV3 {
+				return -result
+			}
+			return result
+		})
+
+		start = end + 1
+	}
+
+	if slices.Equal(variantNames, normalizedVariants) {
+		return className
+	}
+
+	return strings.Join(normalizedVariants, sorter.Config.Separator) + sorter.Config.Separator + utility
+}
+
+// isOrderedVariant tells whether a variant keeps its place in the chain:
+// variants that change the element a class applies to, arbitrary variants and
+// custom or unknown variants, whose meaning cannot be told.
+func (sorter *Sorter) isOrderedVariant(variant string) bool {
+	name, _ := splitModifier(variant)
+	if _, ok := orderedVariants[name]; ok {
+		return true
+	}
+	if _, ok := sorter.Config.CustomVariants[name]; ok {
+		return true
+	}
+
+	order := sorter.getVariantProperty(variant).Order
+	return order == unknownVariantOrder || order == arbitraryVariantOrder
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// extractVueClassStrings finds the class attributes of the elements of the
+// `<template>` block of a Vue single-file component. Class attributes found
+// elsewhere, such as in test fixtures in `<script>`, are left untouched, but
+// the class functions called by the scripts are sorted.
+func (sorter *Sorter) extractVueClassStrings(content []byte) []classString {
+	var classStrings []classString
+
+	tokenizer := html.NewTokenizer(bytes.NewReader(content))
+
+	offset, templateDepth, inScript := 0, 0, false
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+
+		start := offset
+		raw := tokenizer.Raw()
+		offset += len(raw)
+
+		name, _ := tokenizer.TagName()
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if templateDepth > 0 {
+				classStrings = append(classStrings, sorter.tagClassStrings(raw, start, true)...)
+			}
+
+			// Templates may be nested, as in `<template v-if="...">`.
+			if string(name) == "template" && tokenType == html.StartTagToken {
+				templateDepth++
+			}
+			inScript = templateDepth == 0 && string(name) == "script" && tokenType == html.StartTagToken
+		case html.EndTagToken:
+			if string(name) == "template" && templateDepth > 0 {
+				templateDepth--
+			}
+			inScript = false
+		case html.TextToken:
+			if inScript && len(sorter.Config.ClassFunctions) > 0 {
+				classStrings = append(classStrings, sorter.findJSClassStrings(string(raw), start, jsCode)...)
+			}
+		}
+	}
+
+	return classStrings
+}
+
+// vueAttributeClassStrings returns the class strings of an attribute of a Vue
+// template. Bound attributes, such as `:class` or `v-bind:class`, hold
+// expressions whose class strings are found like the arguments of a class
+// function, as in `:class="['p-4', { 'font-bold': isActive }]"`. They are
+// class attributes when their whole name or the name they bind is one.
+func (sorter *Sorter) vueAttributeClassStrings(name, value string, offset int) []classString {
+	bound, isBound := strings.CutPrefix(name, ":")
+	if !isBound {
+		bound, isBound = strings.CutPrefix(name, "v-bind:")
+	}
+
+	switch {
+	case isBound && (sorter.htmlClassAttributeNameRegex.MatchString(name) || sorter.htmlClassAttributeNameRegex.MatchString(bound)):
+		return sorter.findJSClassStrings(value, offset, jsArguments)
+	case !isBound && sorter.htmlClassAttributeNameRegex.MatchString(name):
+		return sorter.attributeClassStrings(value, offset)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/selene466/go-tailwind-sorter/internal/config"
+)
+
+func TestSortVueClassStrings(t *testing.T) {
+	sorter := newTestSorter(t, config.UserConfig{ClassFunctions: []string{"cn"}}, nil)
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "static class",
+			content: `<template><div class="p-4 flex"></div></template>`,
+			want:    `<template><div class="flex p-4"></div></template>`,
+		},
+		{
+			name:    "bound object",
+			content: `<template><div :class="{ 'p-4 flex': open, 'p-2 block': !open }"></div></template>`,
+			want:    `<template><div :class="{ 'flex p-4': open, 'block p-2': !open }"></div></template>`,
+		},
+		{
+			name:    "bound array",
+			content: `<template><div v-bind:class="['p-4 flex', open && 'p-2 block', { 'mt-2 hidden': closed }]"></div></template>`,
+			want:    `<template><div v-bind:class="['flex p-4', open && 'block p-2', { 'mt-2 hidden': closed }]"></div></template>`,
+		},
+		{
+			name:    "bound condition",
+			content: `<template><div :class="size === 'p-4 flex' ? 'p-4 flex' : 'p-2 block'"></div></template>`,
+			want:    `<template><div :class="size === 'p-4 flex' ? 'flex p-4' : 'block p-2'"></div></template>`,
+		},
+		{
+			name:    "bound and static class",
+			content: `<template><div class="p-4 flex" :class="{ 'p-2 block': open }" :title="'p-4 flex'"></div></template>`,
+			want:    `<template><div class="flex p-4" :class="{ 'block p-2': open }" :title="'p-4 flex'"></div></template>`,
+		},
+		{
+			name:    "nested templates",
+			content: `<template><template v-if="open"><div class="p-4 flex"></div></template><p class="p-2 block"></p></template>`,
+			want:    `<template><template v-if="open"><div class="flex p-4"></div></template><p class="block p-2"></p></template>`,
+		},
+		{
+			name: "script and style",
+			content: `<template><div class="p-4 flex"></div></template>
+<script setup>
+const fixture = '<div class="p-4 flex"></div>';
+const classes = cn('p-4 flex');
+</script>
+<style>/* class="p-4 flex" */</style>`,
+			want: `<template><div class="flex p-4"></div></template>
+<script setup>
+const fixture = '<div class="p-4 flex"></div>';
+const classes = cn('flex p-4');
+</script>
+<style>/* class="p-4 flex" */</style>`,
+		},
+		{
+			name:    "outside the template",
+			content: `<docs><div class="p-4 flex"></div></docs>`,
+			want:    `<docs><div class="p-4 flex"></div></docs>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := sortFile(sorter, "Component.vue", test.content); got != test.want {
+				t.Errorf("got\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}